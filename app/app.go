@@ -7,13 +7,21 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/safayildirim/asset-management-service/internal/asset"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/idempotency"
+	"github.com/safayildirim/asset-management-service/internal/ledger"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	"github.com/safayildirim/asset-management-service/internal/recurring"
+	"github.com/safayildirim/asset-management-service/internal/stream"
 	"github.com/safayildirim/asset-management-service/internal/transaction"
 	"github.com/safayildirim/asset-management-service/internal/transaction/scheduler"
+	"github.com/safayildirim/asset-management-service/internal/walletcache"
+	"github.com/safayildirim/asset-management-service/internal/webhook"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
 	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
 	"github.com/safayildirim/asset-management-service/pkg/config"
 	"github.com/safayildirim/asset-management-service/pkg/db"
 	"github.com/safayildirim/asset-management-service/pkg/log"
-	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"net/http"
 	"os"
@@ -27,10 +35,11 @@ type Handler interface {
 }
 
 type App struct {
-	Config   config.Config
-	DB       *gorm.DB
-	Server   *echo.Echo
-	Handlers []Handler
+	Config      config.Config
+	DB          *gorm.DB
+	Server      *echo.Echo
+	Handlers    []Handler
+	Middlewares []echo.MiddlewareFunc
 }
 
 func New() *App {
@@ -46,16 +55,8 @@ func New() *App {
 	// Create Echo instance
 	server := echo.New()
 
-	server.HTTPErrorHandler = func(err error, c echo.Context) {
-		log.Logger.Error(err.Error(), zap.String("method", c.Request().Method),
-			zap.String("path", c.Request().URL.Path))
-
-		var httpError *echo.HTTPError
-		errors.As(err, &httpError)
-
-		// Default error handler behavior
-		c.JSON(httpError.Code, err)
-	}
+	// Render every error as RFC 7807 problem details carrying a stable error code
+	server.HTTPErrorHandler = apperror.HTTPErrorHandler
 
 	// Configure middleware
 	server.Use(middleware.Logger())
@@ -63,28 +64,94 @@ func New() *App {
 
 	var handlers []Handler
 
+	catalogRepository := catalog.NewRepository(dbInstance)
+	catalogService := catalog.NewService(catalogRepository)
+	catalogHandler := catalog.NewHandler(catalogService)
+
+	ledgerRepository := ledger.NewRepository(dbInstance)
+	ledgerService := ledger.NewService(ledgerRepository)
+	ledgerHandler := ledger.NewHandler(ledgerService)
+
+	// Events are written to the outbox along with the state changes they describe and published by the relay to the
+	// webhook subscriptions, whose deliveries are posted by the dispatcher
+	webhookRepository := webhook.NewRepository(dbInstance)
+	webhookService := webhook.NewService(webhookRepository)
+	webhookHandler := webhook.NewHandler(webhookService)
+	go webhook.NewDispatcher(cfg.Webhook, webhookRepository).Start(context.Background())
+
+	// Published events are also appended to the stream that clients follow through server-sent events
+	streamRepository := stream.NewRepository(dbInstance)
+	streamService := stream.NewService(cfg.Stream, streamRepository)
+	streamHandler := stream.NewHandler(streamService)
+	server.Server.RegisterOnShutdown(streamHandler.Close)
+
+	outboxRepository := outbox.NewRepository(dbInstance)
+	publisher := outbox.MultiPublisher{webhook.NewPublisher(webhookRepository), stream.NewPublisher(streamRepository)}
+	go outbox.NewRelay(cfg.Outbox, outboxRepository, publisher).Start(context.Background())
+
 	assetRepository := asset.NewRepository(dbInstance)
-	walletClient := wallet.NewClient(cfg.WalletClient.BaseURL)
-	assetService := asset.NewService(assetRepository, walletClient)
+	walletHTTPClient := wallet.NewClient(cfg.WalletClient.BaseURL, time.Duration(cfg.WalletClient.Timeout)*time.Second,
+		cfg.WalletClient.Concurrency)
+	resilientWalletClient := wallet.NewResilientClient(walletHTTPClient, wallet.ResilienceConfig{
+		MaxRetries:       cfg.WalletClient.MaxRetries,
+		RetryBaseDelay:   time.Duration(cfg.WalletClient.RetryBaseDelay) * time.Millisecond,
+		RetryMaxDelay:    time.Duration(cfg.WalletClient.RetryMaxDelay) * time.Millisecond,
+		RetryJitter:      float64(cfg.WalletClient.RetryJitter) / 100,
+		FailureThreshold: cfg.WalletClient.BreakerThreshold,
+		OpenDuration:     time.Duration(cfg.WalletClient.BreakerOpenDuration) * time.Second,
+	})
+	walletClient := wallet.NewCachedClient(resilientWalletClient, wallet.CacheConfig{
+		TTL:         time.Duration(cfg.WalletClient.CacheTTL) * time.Second,
+		NegativeTTL: time.Duration(cfg.WalletClient.CacheNegativeTTL) * time.Second,
+		MaxEntries:  cfg.WalletClient.CacheMaxEntries,
+	})
+	walletCacheHandler := walletcache.NewHandler(walletClient)
+
+	// Reject wallets the wallet service reports as deleted, and assets used on networks that do not support them
+	assetNetworks, err := wallet.ParseAssetNetworks(cfg.WalletClient.AssetNetworks)
+	if err != nil {
+		panic(err)
+	}
+	walletRules := wallet.NewRules(assetNetworks)
+
+	assetService := asset.NewService(assetRepository, ledgerRepository, outboxRepository, catalogService, walletClient,
+		walletRules)
 	assetHandler := asset.NewHandler(assetService)
 
 	transactionRepository := transaction.NewRepository(dbInstance)
-	transactionService := transaction.NewService(assetRepository, transactionRepository, walletClient)
+	transactionService := transaction.NewService(assetRepository, assetService, transactionRepository, outboxRepository,
+		catalogService, walletClient, walletRules)
 	transactionHandler := transaction.NewHandler(transactionService)
 
-	schedulerManager := scheduler.NewScheduler(cfg.Scheduler, assetService, transactionRepository)
+	recurringRepository := recurring.NewRepository(dbInstance)
+	recurringService := recurring.NewService(cfg.Recurring, recurringRepository, transactionRepository,
+		outboxRepository, assetRepository, assetService, catalogService, walletClient, walletRules)
+	recurringHandler := recurring.NewHandler(recurringService)
+
+	schedulerManager := scheduler.NewScheduler(cfg.Scheduler, assetService, recurringService, transactionRepository,
+		outboxRepository)
 	go schedulerManager.Start(context.Background())
 
-	handlers = append(handlers, assetHandler, transactionHandler)
+	handlers = append(handlers, catalogHandler, assetHandler, transactionHandler, ledgerHandler, recurringHandler,
+		walletCacheHandler, webhookHandler, streamHandler)
+
+	idempotencyRepository := idempotency.NewRepository(dbInstance)
+	go idempotency.StartCleanup(context.Background(), idempotencyRepository,
+		time.Duration(cfg.Idempotency.CleanupInterval)*time.Second)
+
+	middlewares := []echo.MiddlewareFunc{
+		idempotency.Middleware(idempotencyRepository, time.Duration(cfg.Idempotency.TTL)*time.Second,
+			time.Duration(cfg.Idempotency.LockTimeout)*time.Second),
+	}
 
-	return &App{Config: *cfg, DB: dbInstance, Server: server, Handlers: handlers}
+	return &App{Config: *cfg, DB: dbInstance, Server: server, Handlers: handlers, Middlewares: middlewares}
 }
 
 func (a *App) Run() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	route := a.Server.Group("/api")
+	route := a.Server.Group("/api", a.Middlewares...)
 
 	for _, handler := range a.Handlers {
 		handler.RegisterRoutes(route)
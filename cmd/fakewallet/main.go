@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/fake"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+)
+
+// main runs a fake wallet service, so that the service can be run locally and tested end to end without the real one
+func main() {
+	port := flag.Int("port", 8080, "port to listen on")
+	prefix := flag.String("prefix", "/api", "path prefix of the wallet endpoints")
+	fixture := flag.String("fixture", "cmd/fakewallet/wallets.json", "JSON file holding the wallets to serve")
+	latency := flag.Duration("latency", 0, "delay of every response")
+	burstEvery := flag.Int("burst-every", 0, "length of the period in requests within which a failure burst occurs")
+	burstLength := flag.Int("burst-length", 0, "number of requests failing at the end of every period")
+	burstStatus := flag.Int("burst-status", 503, "status code of the failing requests")
+	disableBulk := flag.Bool("disable-bulk", false, "answer the bulk endpoint with 404 Not Found")
+	flag.Parse()
+
+	var wallets []entity.Wallet
+	if *fixture != "" {
+		var err error
+		wallets, err = fake.LoadFixture(*fixture)
+		if err != nil {
+			log.Logger.Sugar().Fatal(err)
+		}
+	}
+
+	server := fake.NewServer(wallets, fake.Faults{
+		Latency:     *latency,
+		BurstEvery:  *burstEvery,
+		BurstLength: *burstLength,
+		BurstStatus: *burstStatus,
+		DisableBulk: *disableBulk,
+	})
+
+	e := echo.New()
+	e.Use(middleware.Logger())
+	e.Use(middleware.Recover())
+	server.RegisterRoutes(e.Group(*prefix))
+
+	log.Logger.Info(fmt.Sprintf("Serving %d wallets on :%d%s", len(wallets), *port, *prefix))
+	if err := e.Start(fmt.Sprintf(":%d", *port)); err != nil {
+		log.Logger.Sugar().Fatal(err)
+	}
+}
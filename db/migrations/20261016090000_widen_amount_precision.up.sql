@@ -0,0 +1,5 @@
+ALTER TABLE assets
+    ALTER COLUMN "amount" TYPE NUMERIC(36, 18);
+
+ALTER TABLE scheduled_transactions
+    ALTER COLUMN "amount" TYPE NUMERIC(36, 18);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS asset_definitions
+(
+    "id"                serial PRIMARY KEY,
+    "created_at"        timestamp      NOT NULL DEFAULT now(),
+    "updated_at"        timestamp               DEFAULT NULL,
+    "symbol"            VARCHAR(16)    NOT NULL,
+    "display_name"      VARCHAR(255)   NOT NULL,
+    "decimals"          integer        NOT NULL CHECK (decimals BETWEEN 0 AND 18),
+    "min_transfer_unit" NUMERIC(36, 18) NOT NULL DEFAULT 0,
+    "enabled"           boolean        NOT NULL DEFAULT true,
+    unique (symbol)
+);
+
+INSERT INTO asset_definitions (symbol, display_name, decimals, min_transfer_unit)
+VALUES ('BTC', 'Bitcoin', 8, 0.00000001),
+       ('ETH', 'Ether', 18, 0.000000000000000001),
+       ('USDT', 'Tether USD', 6, 0.000001)
+ON CONFLICT (symbol) DO NOTHING;
@@ -0,0 +1,2 @@
+ALTER TABLE assets
+    ADD COLUMN IF NOT EXISTS "version" integer NOT NULL DEFAULT 0;
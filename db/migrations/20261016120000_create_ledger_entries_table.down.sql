@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS ledger_entries_immutable ON ledger_entries;
+DROP FUNCTION IF EXISTS prevent_ledger_entry_change();
+DROP TABLE IF EXISTS ledger_entries;
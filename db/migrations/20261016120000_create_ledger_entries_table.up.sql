@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS ledger_entries
+(
+    "id"             bigserial PRIMARY KEY,
+    "created_at"     timestamp       NOT NULL DEFAULT now(),
+    "journal_id"     VARCHAR(64)     NOT NULL,
+    "wallet_id"      integer                  DEFAULT NULL,
+    "asset_name"     VARCHAR(255)    NOT NULL,
+    "direction"      VARCHAR(16)     NOT NULL CHECK (direction IN ('debit', 'credit')),
+    "amount"         NUMERIC(36, 18) NOT NULL CHECK (amount > 0),
+    "balance_after"  NUMERIC(36, 18)          DEFAULT NULL,
+    "reference_type" VARCHAR(64)     NOT NULL,
+    "reference_id"   VARCHAR(255)             DEFAULT NULL
+);
+
+CREATE INDEX idx_ledger_entries_wallet_created ON ledger_entries (wallet_id, created_at);
+CREATE INDEX idx_ledger_entries_journal ON ledger_entries (journal_id);
+CREATE INDEX idx_ledger_entries_reference ON ledger_entries (reference_type, reference_id);
+
+-- Ledger entries are append-only; corrections must be booked as new entries
+CREATE OR REPLACE FUNCTION prevent_ledger_entry_change() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'ledger entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_entries_immutable
+    BEFORE UPDATE OR DELETE
+    ON ledger_entries
+    FOR EACH ROW
+EXECUTE FUNCTION prevent_ledger_entry_change();
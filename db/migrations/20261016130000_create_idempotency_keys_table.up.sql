@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    "id"            bigserial PRIMARY KEY,
+    "created_at"    timestamp    NOT NULL DEFAULT now(),
+    "expires_at"    timestamp    NOT NULL,
+    "key"           VARCHAR(255) NOT NULL UNIQUE,
+    "method"        VARCHAR(16)  NOT NULL,
+    "path"          VARCHAR(255) NOT NULL,
+    "request_hash"  VARCHAR(64)  NOT NULL,
+    "status_code"   integer               DEFAULT NULL,
+    "content_type"  VARCHAR(255)          DEFAULT NULL,
+    "response_body" bytea                 DEFAULT NULL
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_scheduled_transactions_claim;
+
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "claim_expires_at",
+    DROP COLUMN IF EXISTS "claimed_by";
@@ -0,0 +1,5 @@
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "claimed_by"       VARCHAR(255) DEFAULT NULL,
+    ADD COLUMN "claim_expires_at" timestamp    DEFAULT NULL;
+
+CREATE INDEX idx_scheduled_transactions_claim ON scheduled_transactions (status, scheduled_at, claim_expires_at);
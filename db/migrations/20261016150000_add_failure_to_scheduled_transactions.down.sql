@@ -0,0 +1,4 @@
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "last_attempt_at",
+    DROP COLUMN IF EXISTS "attempts",
+    DROP COLUMN IF EXISTS "failure_reason";
@@ -0,0 +1,4 @@
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "failure_reason"  VARCHAR(64) DEFAULT NULL,
+    ADD COLUMN "attempts"        integer     NOT NULL DEFAULT 0,
+    ADD COLUMN "last_attempt_at" timestamp   DEFAULT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "next_attempt_at";
@@ -0,0 +1,2 @@
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "next_attempt_at" timestamp DEFAULT NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_scheduled_transactions_recurring_schedule;
+
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "recurring_schedule_id";
+
+DROP TABLE IF EXISTS recurring_schedules;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS recurring_schedules
+(
+    "id"                    serial PRIMARY KEY,
+    "created_at"            timestamp       NOT NULL DEFAULT now(),
+    "updated_at"            timestamp                DEFAULT NULL,
+    "source_wallet_id"      integer         NOT NULL,
+    "destination_wallet_id" integer         NOT NULL,
+    "asset_name"            VARCHAR(255)    NOT NULL,
+    "amount"                NUMERIC(36, 18) NOT NULL,
+    "cron_expression"       VARCHAR(255)             DEFAULT NULL,
+    "interval"              VARCHAR(16)              DEFAULT NULL CHECK (interval IN ('daily', 'weekly', 'monthly')),
+    "start_at"              timestamp       NOT NULL,
+    "end_at"                timestamp                DEFAULT NULL,
+    "max_occurrences"       integer                  DEFAULT NULL,
+    "occurrences"           integer         NOT NULL DEFAULT 0,
+    "next_run_at"           timestamp                DEFAULT NULL,
+    "status"                VARCHAR(255)    NOT NULL DEFAULT 'active',
+    CHECK ((cron_expression IS NULL) <> (interval IS NULL))
+);
+
+CREATE INDEX idx_recurring_schedules_status_next_run ON recurring_schedules (status, next_run_at);
+
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "recurring_schedule_id" integer DEFAULT NULL REFERENCES recurring_schedules (id);
+
+CREATE INDEX idx_scheduled_transactions_recurring_schedule ON scheduled_transactions (recurring_schedule_id);
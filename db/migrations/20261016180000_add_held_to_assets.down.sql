@@ -0,0 +1,6 @@
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "held";
+
+ALTER TABLE assets
+    DROP CONSTRAINT IF EXISTS assets_held_within_amount,
+    DROP COLUMN IF EXISTS "held";
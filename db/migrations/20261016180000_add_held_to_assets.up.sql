@@ -0,0 +1,6 @@
+ALTER TABLE assets
+    ADD COLUMN IF NOT EXISTS "held" NUMERIC(36, 18) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT assets_held_within_amount CHECK (held >= 0 AND held <= amount);
+
+ALTER TABLE scheduled_transactions
+    ADD COLUMN IF NOT EXISTS "held" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_scheduled_transactions_batch;
+
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "batch_id";
+
+DROP TABLE IF EXISTS transfer_batches;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS transfer_batches
+(
+    "id"               serial PRIMARY KEY,
+    "created_at"       timestamp    NOT NULL DEFAULT now(),
+    "updated_at"       timestamp             DEFAULT NULL,
+    "status"           VARCHAR(255) NOT NULL,
+    "scheduled_at"     timestamp    NOT NULL,
+    "failure_reason"   VARCHAR(255)          DEFAULT NULL,
+    "attempts"         integer      NOT NULL DEFAULT 0,
+    "last_attempt_at"  timestamp             DEFAULT NULL,
+    "next_attempt_at"  timestamp             DEFAULT NULL,
+    "claimed_by"       VARCHAR(255)          DEFAULT NULL,
+    "claim_expires_at" timestamp             DEFAULT NULL
+);
+
+CREATE INDEX idx_transfer_batches_claim ON transfer_batches (status, scheduled_at, claim_expires_at);
+
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "batch_id" integer DEFAULT NULL REFERENCES transfer_batches (id);
+
+CREATE INDEX idx_scheduled_transactions_batch ON scheduled_transactions (batch_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_scheduled_transactions_reversal_of;
+
+ALTER TABLE scheduled_transactions
+    DROP COLUMN IF EXISTS "reversed_amount",
+    DROP COLUMN IF EXISTS "reversal_of";
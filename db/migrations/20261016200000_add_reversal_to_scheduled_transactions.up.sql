@@ -0,0 +1,5 @@
+ALTER TABLE scheduled_transactions
+    ADD COLUMN "reversal_of"     integer         DEFAULT NULL REFERENCES scheduled_transactions (id),
+    ADD COLUMN "reversed_amount" NUMERIC(36, 18) NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_scheduled_transactions_reversal_of ON scheduled_transactions (reversal_of);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_scheduled_transactions_amount_id;
+DROP INDEX IF EXISTS idx_scheduled_transactions_scheduled_at_id;
+DROP INDEX IF EXISTS idx_scheduled_transactions_created_at_id;
+
+DROP INDEX IF EXISTS idx_assets_amount_id;
+DROP INDEX IF EXISTS idx_assets_created_at_id;
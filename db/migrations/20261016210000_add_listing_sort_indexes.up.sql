@@ -0,0 +1,6 @@
+CREATE INDEX idx_assets_created_at_id ON assets (created_at, id);
+CREATE INDEX idx_assets_amount_id ON assets (amount, id);
+
+CREATE INDEX idx_scheduled_transactions_created_at_id ON scheduled_transactions (created_at, id);
+CREATE INDEX idx_scheduled_transactions_scheduled_at_id ON scheduled_transactions (scheduled_at, id);
+CREATE INDEX idx_scheduled_transactions_amount_id ON scheduled_transactions (amount, id);
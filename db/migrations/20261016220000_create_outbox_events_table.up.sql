@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS outbox_events
+(
+    "id"               bigserial PRIMARY KEY,
+    "created_at"       timestamp    NOT NULL DEFAULT now(),
+    "type"             VARCHAR(64)  NOT NULL,
+    "aggregate_type"   VARCHAR(64)  NOT NULL,
+    "aggregate_id"     integer      NOT NULL,
+    "payload"          jsonb        NOT NULL,
+    "attempts"         integer      NOT NULL DEFAULT 0,
+    "last_error"       TEXT                  DEFAULT NULL,
+    "next_attempt_at"  timestamp             DEFAULT NULL,
+    "published_at"     timestamp             DEFAULT NULL,
+    "claimed_by"       VARCHAR(255)          DEFAULT NULL,
+    "claim_expires_at" timestamp             DEFAULT NULL
+);
+
+-- The relay only ever looks for events that have not been published yet
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events (id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_type, aggregate_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_webhook_delivery_attempts_delivery;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    "id"          serial PRIMARY KEY,
+    "created_at"  timestamp     NOT NULL DEFAULT now(),
+    "updated_at"  timestamp              DEFAULT NULL,
+    "url"         VARCHAR(2048) NOT NULL,
+    "secret"      VARCHAR(255)  NOT NULL,
+    "event_types" text[]        NOT NULL DEFAULT '{}',
+    "wallet_ids"  bigint[]      NOT NULL DEFAULT '{}',
+    "active"      boolean       NOT NULL DEFAULT true
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    "id"               bigserial PRIMARY KEY,
+    "created_at"       timestamp    NOT NULL DEFAULT now(),
+    "updated_at"       timestamp             DEFAULT NULL,
+    "subscription_id"  integer      NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    "event_id"         bigint       NOT NULL,
+    "event_type"       VARCHAR(64)  NOT NULL,
+    "payload"          jsonb        NOT NULL,
+    "status"           VARCHAR(255) NOT NULL,
+    "attempts"         integer      NOT NULL DEFAULT 0,
+    "last_attempt_at"  timestamp             DEFAULT NULL,
+    "next_attempt_at"  timestamp             DEFAULT NULL,
+    "response_status"  integer               DEFAULT NULL,
+    "last_error"       TEXT                  DEFAULT NULL,
+    "delivered_at"     timestamp             DEFAULT NULL,
+    "claimed_by"       VARCHAR(255)          DEFAULT NULL,
+    "claim_expires_at" timestamp             DEFAULT NULL,
+    -- The relay publishes an event at least once, but each subscription receives it in a single delivery
+    UNIQUE ("subscription_id", "event_id")
+);
+
+-- The dispatcher only ever looks for deliveries that are still pending
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries (id) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts
+(
+    "id"              bigserial PRIMARY KEY,
+    "created_at"      timestamp NOT NULL DEFAULT now(),
+    "delivery_id"     bigint    NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    "response_status" integer            DEFAULT NULL,
+    "error"           TEXT               DEFAULT NULL,
+    "duration_ms"     integer   NOT NULL
+);
+
+CREATE INDEX idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts (delivery_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stream_events_wallet_ids;
+
+DROP TABLE IF EXISTS stream_events;
@@ -0,0 +1,14 @@
+-- Published events in the order they became visible, so that stream clients can resume from the last event they saw
+CREATE TABLE IF NOT EXISTS stream_events
+(
+    "sequence"       bigserial PRIMARY KEY,
+    "created_at"     timestamp   NOT NULL,
+    "event_id"       bigint      NOT NULL UNIQUE,
+    "type"           VARCHAR(64) NOT NULL,
+    "aggregate_type" VARCHAR(64) NOT NULL,
+    "aggregate_id"   integer     NOT NULL,
+    "wallet_ids"     bigint[]    NOT NULL DEFAULT '{}',
+    "payload"        jsonb       NOT NULL
+);
+
+CREATE INDEX idx_stream_events_wallet_ids ON stream_events USING gin (wallet_ids);
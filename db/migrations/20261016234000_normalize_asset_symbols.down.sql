@@ -0,0 +1,2 @@
+-- The original spelling of the names and the merged balances are not kept, so normalisation cannot be undone
+SELECT 1;
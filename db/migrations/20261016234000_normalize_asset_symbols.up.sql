@@ -0,0 +1,70 @@
+-- Asset names are matched against catalogue symbols, which are upper case without surrounding spaces. Rows written
+-- before names were normalised are renamed to the symbol they stand for: either the symbol itself in another case or
+-- spacing, e.g. 'btc ', or the display name of exactly one catalogue asset, e.g. 'Bitcoin'. Assets of a wallet whose
+-- names stand for the same symbol are merged into the oldest of them. Ledger entries and recurring schedules were
+-- always written with canonical symbols.
+CREATE TEMPORARY TABLE asset_symbols AS
+SELECT names.name,
+       coalesce((SELECT symbol FROM asset_definitions WHERE symbol = upper(trim(names.name))),
+                (SELECT min(symbol)
+                 FROM asset_definitions
+                 WHERE lower(trim(display_name)) = lower(trim(names.name))
+                 HAVING count(*) = 1)) AS symbol
+FROM (SELECT name FROM assets UNION SELECT asset_name FROM scheduled_transactions) names;
+
+-- Names that stand for no catalogue asset would become unusable, so they have to be renamed or added to the
+-- catalogue by hand first; failing here rolls the whole migration back
+DO
+$$
+DECLARE
+    unmapped TEXT;
+BEGIN
+    SELECT string_agg(quote_literal(name), ', ' ORDER BY name) INTO unmapped FROM asset_symbols WHERE symbol IS NULL;
+
+    IF unmapped IS NOT NULL THEN
+        RAISE EXCEPTION 'asset names without a catalogue symbol: %', unmapped
+            USING HINT = 'Rename them or add them to asset_definitions, then run the migration again.';
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+WITH groups AS (SELECT assets.id,
+                       first_value(assets.id)
+                       OVER (PARTITION BY assets.wallet_id, asset_symbols.symbol ORDER BY assets.id) AS keep_id
+                FROM assets
+                         JOIN asset_symbols ON asset_symbols.name = assets.name),
+     totals AS (SELECT groups.keep_id, sum(assets.amount) AS amount, sum(assets.held) AS held
+                FROM assets
+                         JOIN groups ON groups.id = assets.id
+                GROUP BY groups.keep_id
+                HAVING count(*) > 1)
+UPDATE assets
+SET amount     = totals.amount,
+    held       = totals.held,
+    version    = assets.version + 1,
+    updated_at = now()
+FROM totals
+WHERE assets.id = totals.keep_id;
+
+DELETE
+FROM assets duplicate
+    USING assets kept, asset_symbols duplicate_symbol, asset_symbols kept_symbol
+WHERE duplicate.wallet_id = kept.wallet_id
+  AND duplicate_symbol.name = duplicate.name
+  AND kept_symbol.name = kept.name
+  AND duplicate_symbol.symbol = kept_symbol.symbol
+  AND duplicate.id > kept.id;
+
+UPDATE assets
+SET name = asset_symbols.symbol
+FROM asset_symbols
+WHERE asset_symbols.name = assets.name
+  AND assets.name <> asset_symbols.symbol;
+
+UPDATE scheduled_transactions
+SET asset_name = asset_symbols.symbol
+FROM asset_symbols
+WHERE asset_symbols.name = scheduled_transactions.asset_name
+  AND scheduled_transactions.asset_name <> asset_symbols.symbol;
+
+DROP TABLE asset_symbols;
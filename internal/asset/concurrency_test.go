@@ -0,0 +1,230 @@
+package asset
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset/entity"
+	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	catalogentity "github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	outboxmock "github.com/safayildirim/asset-management-service/internal/outbox/mock"
+	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// memoryRepository is an in-memory Repository that mimics the optimistic versioning of the SQL implementation.
+// Reads return copies and yield the processor, which makes read-modify-write races between goroutines likely.
+type memoryRepository struct {
+	mu     sync.Mutex
+	nextID uint
+	assets map[uint]*entity.Asset
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{assets: map[uint]*entity.Asset{}}
+}
+
+func (r *memoryRepository) Deposit(_ context.Context, _ *gorm.DB, item *entity.Asset) (*entity.Asset, error) {
+	return item, nil
+}
+
+func (r *memoryRepository) Withdraw(_ context.Context, _ *gorm.DB, item *entity.Asset) (*entity.Asset, error) {
+	return item, nil
+}
+
+func (r *memoryRepository) GetAsset(_ context.Context, _ *gorm.DB, filters entity.Filters) ([]*entity.Asset, error) {
+	r.mu.Lock()
+	var assets []*entity.Asset
+	for _, a := range r.assets {
+		if a.WalletID == filters.WalletID[0] && a.Name == filters.Name[0] {
+			copied := *a
+			assets = append(assets, &copied)
+		}
+	}
+	r.mu.Unlock()
+
+	runtime.Gosched()
+
+	return assets, nil
+}
+
+func (r *memoryRepository) CreateAsset(_ context.Context, _ *gorm.DB, item *entity.Asset) (*entity.Asset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, a := range r.assets {
+		if a.WalletID == item.WalletID && a.Name == item.Name {
+			return nil, ErrDuplicateAsset
+		}
+	}
+
+	r.nextID++
+	item.ID = r.nextID
+	copied := *item
+	r.assets[item.ID] = &copied
+
+	return item, nil
+}
+
+func (r *memoryRepository) CreateAssetIfMissing(ctx context.Context, tx *gorm.DB, item *entity.Asset) (bool, error) {
+	_, err := r.CreateAsset(ctx, tx, item)
+	if errors.Is(err, ErrDuplicateAsset) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (r *memoryRepository) UpdateAsset(_ context.Context, _ *gorm.DB, item *entity.Asset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.assets[item.ID]
+	if !ok || stored.Version != item.Version {
+		return ErrConcurrentUpdate
+	}
+
+	item.Version++
+	stored.Amount = item.Amount
+	stored.Held = item.Held
+	stored.Version = item.Version
+
+	return nil
+}
+
+func (r *memoryRepository) InTransaction(_ context.Context, fn func(tx *gorm.DB) error) error {
+	return fn(nil)
+}
+
+// memoryLedger is an in-memory ledger.Repository that keeps every journal it receives
+type memoryLedger struct {
+	mu      sync.Mutex
+	entries []*ledgerentity.Entry
+}
+
+func (l *memoryLedger) CreateEntries(_ context.Context, _ *gorm.DB, entries []*ledgerentity.Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, entries...)
+	return nil
+}
+
+func (l *memoryLedger) GetEntries(_ context.Context, _ ledgerentity.Filters) ([]*ledgerentity.Entry, error) {
+	return l.entries, nil
+}
+
+func TestService_ConcurrentBalanceUpdates(t *testing.T) {
+	const (
+		workers    = 50
+		operations = 20
+	)
+
+	repository := newMemoryRepository()
+	_, err := repository.CreateAsset(context.Background(), nil, &entity.Asset{
+		WalletID: 1,
+		Name:     "BTC",
+		Amount:   decimal.RequireFromString("100"),
+	})
+	require.NoError(t, err)
+
+	mockCatalogService := catalogmock.NewMockCatalogService(t)
+	mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "BTC", mock.Anything).
+		Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true}, nil)
+	mockWalletClient := walletmock.NewMockWalletClient(t)
+	mockWalletClient.EXPECT().GetWallet(mock.Anything, uint(1)).Return(&walletentity.Wallet{ID: 1}, nil)
+
+	mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+	mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	journal := &memoryLedger{}
+	s := NewService(repository, journal, mockOutboxRepository, mockCatalogService, mockWalletClient,
+		walletpkg.NewRules(nil))
+
+	depositAmount := decimal.RequireFromString("0.1")
+	withdrawAmount := decimal.RequireFromString("0.3")
+
+	var (
+		mu                 sync.Mutex
+		deposited          = decimal.Zero
+		withdrawn          = decimal.Zero
+		insufficientErrors int
+		wg                 sync.WaitGroup
+	)
+
+	// Every worker alternates deposits and withdrawals against the same wallet and asset
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < operations; i++ {
+				if (worker+i)%2 == 0 {
+					_, err := s.Deposit(context.Background(), nil, &request.CreateDepositRequest{
+						WalletID: 1, Name: "BTC", Amount: depositAmount,
+					})
+					if err == nil {
+						mu.Lock()
+						deposited = deposited.Add(depositAmount)
+						mu.Unlock()
+						continue
+					}
+					assert.ErrorIs(t, err, ErrConcurrentUpdate)
+					continue
+				}
+
+				_, err := s.Withdraw(context.Background(), nil, &request.CreateWithdrawRequest{
+					WalletID: 1, Name: "BTC", Amount: withdrawAmount,
+				})
+				mu.Lock()
+				switch {
+				case err == nil:
+					withdrawn = withdrawn.Add(withdrawAmount)
+				case errors.Is(err, ErrInsufficientBalance):
+					insufficientErrors++
+				default:
+					assert.ErrorIs(t, err, ErrConcurrentUpdate)
+				}
+				mu.Unlock()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assets, err := repository.GetAsset(context.Background(), nil, entity.Filters{
+		WalletID: []uint{1},
+		Name:     []string{"BTC"},
+	})
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+
+	// The stored balance must reflect exactly the operations that reported success
+	expected := decimal.RequireFromString("100").Add(deposited).Sub(withdrawn)
+	assert.True(t, expected.Equal(assets[0].Amount), "expected balance %s, got %s", expected, assets[0].Amount)
+	assert.False(t, assets[0].Amount.IsNegative())
+	assert.True(t, withdrawn.IsPositive())
+
+	// Replaying the wallet's ledger entries must explain the final balance exactly
+	replayed := decimal.RequireFromString("100")
+	for _, e := range journal.entries {
+		if !e.WalletID.Valid {
+			continue
+		}
+		if e.Direction == ledgerentity.Credit {
+			replayed = replayed.Add(e.Amount)
+		} else {
+			replayed = replayed.Sub(e.Amount)
+		}
+	}
+	assert.True(t, replayed.Equal(assets[0].Amount), "ledger replays to %s, balance is %s", replayed,
+		assets[0].Amount)
+}
@@ -1,15 +1,36 @@
 package entity
 
 import (
+	"encoding/json"
 	"time"
 )
-import "gopkg.in/guregu/null.v3"
+import (
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+)
 
 type Asset struct {
-	ID        uint      `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt null.Time `json:"updated_at"`
-	WalletID  uint      `json:"wallet_id"`
-	Name      string    `json:"name"`
-	Amount    float64   `json:"amount"`
+	ID        uint            `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt null.Time       `json:"updated_at"`
+	WalletID  uint            `json:"wallet_id"`
+	Name      string          `json:"name"`
+	Amount    decimal.Decimal `json:"amount"`
+	Held      decimal.Decimal `json:"held"`
+	Version   uint            `json:"-"`
+}
+
+// Available returns the part of the balance that is not reserved by holds for scheduled transfers
+func (a Asset) Available() decimal.Decimal {
+	return a.Amount.Sub(a.Held)
+}
+
+// MarshalJSON adds the available balance to the JSON representation of the asset
+func (a Asset) MarshalJSON() ([]byte, error) {
+	type alias Asset
+
+	return json.Marshal(struct {
+		alias
+		Available decimal.Decimal `json:"available"`
+	}{alias: alias(a), Available: a.Available()})
 }
@@ -1,7 +1,16 @@
 package entity
 
+import (
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+)
+
 type Filters struct {
-	ID       []uint
-	Name     []string
-	WalletID []uint
+	ID        []uint
+	Name      []string
+	WalletID  []uint
+	MinAmount *decimal.Decimal
+	MaxAmount *decimal.Decimal
+	// Page restricts the result to a page of the listing; all matching assets are returned when it is nil
+	Page *common.PageQuery
 }
@@ -1,7 +1,15 @@
 package asset
 
-import "github.com/pkg/errors"
+import (
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
 
 var (
-	ErrDuplicateAsset = errors.New("asset already exist")
+	ErrDuplicateAsset      = apperror.New(apperror.CodeAssetAlreadyExists, http.StatusConflict, "asset already exist")
+	ErrAssetNotFound       = apperror.New(apperror.CodeAssetNotFound, http.StatusNotFound, "asset not found")
+	ErrConcurrentUpdate    = apperror.New(apperror.CodeConcurrentUpdate, http.StatusConflict, "asset was modified concurrently")
+	ErrInsufficientBalance = apperror.New(apperror.CodeInsufficientBalance, http.StatusConflict, "insufficient balance")
+	ErrInsufficientHold    = apperror.New(apperror.CodeInsufficientHold, http.StatusConflict,
+		"held amount is lower than the requested amount")
 )
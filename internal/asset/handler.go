@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"github.com/gorilla/schema"
 	"github.com/labstack/echo/v4"
-	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/asset/request"
 	"github.com/safayildirim/asset-management-service/internal/common"
-	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/shopspring/decimal"
 	"net/http"
 	"reflect"
 	"strings"
@@ -23,6 +22,14 @@ func init() {
 	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
 		return reflect.ValueOf(strings.Split(value, ","))
 	})
+	// Parse amount filters as exact decimals; an invalid value yields a decoding error
+	decoder.RegisterConverter(decimal.Decimal{}, func(value string) reflect.Value {
+		amount, err := decimal.NewFromString(value)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(amount)
+	})
 }
 
 type Handler struct {
@@ -38,6 +45,7 @@ func NewHandler(assetService Service) *Handler {
 func (h Handler) RegisterRoutes(e *echo.Group) {
 	e.POST("/assets", h.CreateAsset)
 	e.GET("/assets", h.GetAssets)
+	e.GET("/assets/:id", h.GetAsset)
 	e.POST("/assets/deposit", h.Deposit)
 	e.POST("/assets/withdraw", h.Withdraw)
 }
@@ -56,12 +64,7 @@ func (h Handler) CreateAsset(ctx echo.Context) error {
 
 	asset, err := h.assetService.CreateAsset(ctx.Request().Context(), nil, &req)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrDuplicateAsset):
-			return echo.NewHTTPError(http.StatusConflict, err.Error())
-		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.JSON(http.StatusCreated, common.Response{Data: asset})
@@ -77,12 +80,31 @@ func (h Handler) GetAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	assets, err := h.assetService.GetAssets(ctx.Request().Context(), &req)
+	if err = req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	page, err := h.assetService.GetAssets(ctx.Request().Context(), &req)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, common.Response{Data: assets})
+	return ctx.JSON(http.StatusOK, common.NewPageResponse(page))
+}
+
+// GetAsset handles requests to fetch a single asset by its ID
+func (h Handler) GetAsset(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	asset, err := h.assetService.GetAsset(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: asset})
 }
 
 // Deposit handles requests to deposit an asset into a wallet
@@ -98,12 +120,7 @@ func (h Handler) Deposit(ctx echo.Context) error {
 
 	asset, err := h.assetService.Deposit(ctx.Request().Context(), nil, &req)
 	if err != nil {
-		switch {
-		case errors.Is(err, walletpkg.ErrWalletNotFound):
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, common.Response{Data: asset})
@@ -123,12 +140,7 @@ func (h Handler) Withdraw(ctx echo.Context) error {
 
 	asset, err := h.assetService.Withdraw(ctx.Request().Context(), nil, &req)
 	if err != nil {
-		switch {
-		case errors.Is(err, walletpkg.ErrWalletNotFound):
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, common.Response{Data: asset})
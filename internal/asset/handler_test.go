@@ -5,7 +5,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
 	assetmock "github.com/safayildirim/asset-management-service/internal/asset/mock"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
 	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v3"
@@ -24,9 +27,10 @@ func TestHandler_GetAssets(t *testing.T) {
 		name                 string
 		query                map[string]string
 		mockService          bool
-		mockReturnData       []*entity.Asset
+		mockReturnData       *common.Page[*entity.Asset]
 		mockReturnErr        error
 		expectedStatus       int
+		expectedBody         string
 		expectErr            bool
 		expectedErrorMessage string
 	}{
@@ -34,18 +38,65 @@ func TestHandler_GetAssets(t *testing.T) {
 			name:        "when name and wallet_id query parameters are provided then should return assets",
 			query:       map[string]string{"name": "BTC", "wallet_id": "1"},
 			mockService: true,
-			mockReturnData: []*entity.Asset{
-				{ID: 1, Name: "BTC", WalletID: 1, Amount: 10},
-				{ID: 2, Name: "ETH", WalletID: 1, Amount: 5},
-			},
+			mockReturnData: &common.Page[*entity.Asset]{Items: []*entity.Asset{
+				{ID: 1, Name: "BTC", WalletID: 1, Amount: decimal.NewFromInt(10)},
+				{ID: 2, Name: "ETH", WalletID: 1, Amount: decimal.NewFromInt(5)},
+			}, Limit: common.DefaultPageLimit},
 			mockReturnErr:  nil,
 			expectedStatus: http.StatusOK,
+			expectedBody:   `"pagination":{"limit":50}`,
+		},
+		{
+			name:        "when more assets follow the page then should return the next cursor",
+			query:       map[string]string{"min_amount": "1.5", "max_amount": "10", "limit": "1", "sort": "-amount"},
+			mockService: true,
+			mockReturnData: &common.Page[*entity.Asset]{Items: []*entity.Asset{
+				{ID: 1, Name: "BTC", WalletID: 1, Amount: decimal.NewFromInt(10)},
+			}, Limit: 1, NextCursor: "next"},
+			mockReturnErr:  nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"pagination":{"limit":1,"next_cursor":"next"}`,
+		},
+		{
+			name:                 "when amount filter is not a decimal then should return bad request",
+			query:                map[string]string{"min_amount": "abc"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "min_amount",
+		},
+		{
+			name:                 "when max amount is lower than min amount then should return bad request",
+			query:                map[string]string{"min_amount": "10", "max_amount": "1"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "max_amount: must not be lower than min_amount",
+		},
+		{
+			name:                 "when limit exceeds the maximum then should return bad request",
+			query:                map[string]string{"limit": "501"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "limit: must be no greater than 500",
+		},
+		{
+			name:                 "when sort field is not supported then should return bad request",
+			query:                map[string]string{"sort": "wallet_id"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "sort: must be one of id, created_at, amount",
+		},
+		{
+			name:                 "when cursor was issued for another sort then should return bad request",
+			query:                map[string]string{"sort": "amount", "cursor": common.Cursor{Sort: "id", ID: 1}.Encode()},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "cursor: invalid cursor",
 		},
 		{
 			name:           "when no query parameters are provided then should return all assets",
 			query:          nil,
 			mockService:    true,
-			mockReturnData: []*entity.Asset{},
+			mockReturnData: &common.Page[*entity.Asset]{Items: []*entity.Asset{}, Limit: common.DefaultPageLimit},
 			mockReturnErr:  nil,
 			expectedStatus: http.StatusOK,
 		},
@@ -96,11 +147,89 @@ func TestHandler_GetAssets(t *testing.T) {
 			err := handler.GetAssets(ctx)
 			if tt.expectErr {
 				assert.Error(t, err)
-				httpErr := err.(*echo.HTTPError)
-				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestHandler_GetAsset(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		assetID              string
+		mockService          bool
+		mockReturnData       *entity.Asset
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when asset exists then should return it",
+			assetID:        "1",
+			mockService:    true,
+			mockReturnData: &entity.Asset{ID: 1, Name: "BTC", WalletID: 1, Amount: decimal.NewFromInt(10)},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when invalid asset ID is provided then should return bad request",
+			assetID:              "invalid",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when asset does not exist then should return not found",
+			assetID:              "1",
+			mockService:          true,
+			mockReturnErr:        ErrAssetNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "asset not found",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			assetID:              "1",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := assetmock.NewMockAssetService(t)
+			handler := NewHandler(mockService)
 
+			if tt.mockService {
+				mockService.EXPECT().GetAsset(mock.Anything, uint(1)).Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/assets/:id", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/assets/:id")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.assetID)
+
+			err := handler.GetAsset(ctx)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), `"available":"10"`)
 			}
 		})
 	}
@@ -129,7 +258,7 @@ func TestHandler_CreateAsset(t *testing.T) {
 				UpdatedAt: null.Time{},
 				WalletID:  1,
 				Name:      "BTC",
-				Amount:    10,
+				Amount:    decimal.NewFromInt(10),
 			},
 			mockError:      nil,
 			expectedStatus: http.StatusCreated,
@@ -220,7 +349,7 @@ func TestHandler_Deposit(t *testing.T) {
 			name:           "when valid request body is provided then should deposit asset",
 			body:           `{"wallet_id":1,"name":"BTC","amount":10}`,
 			mockService:    true,
-			mockReturn:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: 10},
+			mockReturn:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
 			mockError:      nil,
 			expectedStatus: http.StatusOK,
 		},
@@ -242,6 +371,15 @@ func TestHandler_Deposit(t *testing.T) {
 			expectErr:            true,
 			expectedErrorMessage: "validation error",
 		},
+		{
+			name:                 "when negative amount is provided then should return bad request",
+			body:                 `{"wallet_id":1,"name":"BTC","amount":"-10"}`,
+			mockReturn:           nil,
+			mockError:            nil,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "amount: must be greater than zero",
+		},
 		{
 			name:                 "when wallet not found then should return bad request",
 			body:                 `{"wallet_id":1,"name":"BTC","amount":10}`,
@@ -310,7 +448,7 @@ func TestHandler_Withdraw(t *testing.T) {
 			name:           "when valid request body is provided then should withdraw asset",
 			body:           `{"wallet_id":1,"name":"BTC","amount":10}`,
 			mockService:    true,
-			mockReturn:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: 10},
+			mockReturn:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
 			mockError:      nil,
 			expectedStatus: http.StatusOK,
 		},
@@ -332,6 +470,15 @@ func TestHandler_Withdraw(t *testing.T) {
 			expectErr:            true,
 			expectedErrorMessage: "validation error",
 		},
+		{
+			name:                 "when negative amount is provided then should return bad request",
+			body:                 `{"wallet_id":1,"name":"BTC","amount":"-10"}`,
+			mockReturn:           nil,
+			mockError:            nil,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "amount: must be greater than zero",
+		},
 		{
 			name:                 "when wallet not found then should return bad request",
 			body:                 `{"wallet_id":1,"name":"BTC","amount":10}`,
@@ -342,6 +489,16 @@ func TestHandler_Withdraw(t *testing.T) {
 			expectErr:            true,
 			expectedErrorMessage: "wallet not found",
 		},
+		{
+			name:                 "when balance is not enough then should return conflict with insufficient balance",
+			body:                 `{"wallet_id":1,"name":"BTC","amount":10}`,
+			mockService:          true,
+			mockReturn:           nil,
+			mockError:            ErrInsufficientBalance,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "insufficient balance",
+		},
 		{
 			name:                 "when service returns error then should return internal server error",
 			body:                 `{"wallet_id":1,"name":"BTC","amount":10}`,
@@ -376,6 +533,7 @@ func TestHandler_Withdraw(t *testing.T) {
 			if tt.expectErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
 			} else {
 				assert.NoError(t, err)
 			}
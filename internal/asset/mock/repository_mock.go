@@ -6,7 +6,6 @@ import (
 	context "context"
 
 	entity "github.com/safayildirim/asset-management-service/internal/asset/entity"
-
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
@@ -26,8 +25,7 @@ func (_m *MockAssetRepository) EXPECT() *MockAssetRepository_Expecter {
 }
 
 // CreateAsset provides a mock function with given fields: ctx, tx, item
-func (_m *MockAssetRepository) CreateAsset(ctx context.Context, tx *gorm.DB, item *entity.Asset) (*entity.Asset,
-	error) {
+func (_m *MockAssetRepository) CreateAsset(ctx context.Context, tx *gorm.DB, item *entity.Asset) (*entity.Asset, error) {
 	ret := _m.Called(ctx, tx, item)
 
 	if len(ret) == 0 {
@@ -65,27 +63,81 @@ type MockAssetRepository_CreateAsset_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - item *entity.Asset
-func (_e *MockAssetRepository_Expecter) CreateAsset(ctx interface{}, tx interface{},
-	item interface{}) *MockAssetRepository_CreateAsset_Call {
+func (_e *MockAssetRepository_Expecter) CreateAsset(ctx interface{}, tx interface{}, item interface{}) *MockAssetRepository_CreateAsset_Call {
 	return &MockAssetRepository_CreateAsset_Call{Call: _e.mock.On("CreateAsset", ctx, tx, item)}
 }
 
-func (_c *MockAssetRepository_CreateAsset_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	item *entity.Asset)) *MockAssetRepository_CreateAsset_Call {
+func (_c *MockAssetRepository_CreateAsset_Call) Run(run func(ctx context.Context, tx *gorm.DB, item *entity.Asset)) *MockAssetRepository_CreateAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Asset))
+	})
+	return _c
+}
+
+func (_c *MockAssetRepository_CreateAsset_Call) Return(_a0 *entity.Asset, _a1 error) *MockAssetRepository_CreateAsset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssetRepository_CreateAsset_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Asset) (*entity.Asset, error)) *MockAssetRepository_CreateAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAssetIfMissing provides a mock function with given fields: ctx, tx, item
+func (_m *MockAssetRepository) CreateAssetIfMissing(ctx context.Context, tx *gorm.DB, item *entity.Asset) (bool, error) {
+	ret := _m.Called(ctx, tx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssetIfMissing")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Asset) (bool, error)); ok {
+		return rf(ctx, tx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Asset) bool); ok {
+		r0 = rf(ctx, tx, item)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *entity.Asset) error); ok {
+		r1 = rf(ctx, tx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssetRepository_CreateAssetIfMissing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssetIfMissing'
+type MockAssetRepository_CreateAssetIfMissing_Call struct {
+	*mock.Call
+}
+
+// CreateAssetIfMissing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - item *entity.Asset
+func (_e *MockAssetRepository_Expecter) CreateAssetIfMissing(ctx interface{}, tx interface{}, item interface{}) *MockAssetRepository_CreateAssetIfMissing_Call {
+	return &MockAssetRepository_CreateAssetIfMissing_Call{Call: _e.mock.On("CreateAssetIfMissing", ctx, tx, item)}
+}
+
+func (_c *MockAssetRepository_CreateAssetIfMissing_Call) Run(run func(ctx context.Context, tx *gorm.DB, item *entity.Asset)) *MockAssetRepository_CreateAssetIfMissing_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Asset))
 	})
 	return _c
 }
 
-func (_c *MockAssetRepository_CreateAsset_Call) Return(_a0 *entity.Asset,
-	_a1 error) *MockAssetRepository_CreateAsset_Call {
+func (_c *MockAssetRepository_CreateAssetIfMissing_Call) Return(_a0 bool, _a1 error) *MockAssetRepository_CreateAssetIfMissing_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssetRepository_CreateAsset_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Asset) (*entity.Asset, error)) *MockAssetRepository_CreateAsset_Call {
+func (_c *MockAssetRepository_CreateAssetIfMissing_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Asset) (bool, error)) *MockAssetRepository_CreateAssetIfMissing_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -129,13 +181,11 @@ type MockAssetRepository_Deposit_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - _a2 *entity.Asset
-func (_e *MockAssetRepository_Expecter) Deposit(ctx interface{}, tx interface{},
-	_a2 interface{}) *MockAssetRepository_Deposit_Call {
+func (_e *MockAssetRepository_Expecter) Deposit(ctx interface{}, tx interface{}, _a2 interface{}) *MockAssetRepository_Deposit_Call {
 	return &MockAssetRepository_Deposit_Call{Call: _e.mock.On("Deposit", ctx, tx, _a2)}
 }
 
-func (_c *MockAssetRepository_Deposit_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	_a2 *entity.Asset)) *MockAssetRepository_Deposit_Call {
+func (_c *MockAssetRepository_Deposit_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *entity.Asset)) *MockAssetRepository_Deposit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Asset))
 	})
@@ -147,15 +197,14 @@ func (_c *MockAssetRepository_Deposit_Call) Return(_a0 *entity.Asset, _a1 error)
 	return _c
 }
 
-func (_c *MockAssetRepository_Deposit_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Asset) (*entity.Asset, error)) *MockAssetRepository_Deposit_Call {
+func (_c *MockAssetRepository_Deposit_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Asset) (*entity.Asset, error)) *MockAssetRepository_Deposit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAsset provides a mock function with given fields: ctx, filters
-func (_m *MockAssetRepository) GetAsset(ctx context.Context, filters entity.Filters) ([]*entity.Asset, error) {
-	ret := _m.Called(ctx, filters)
+// GetAsset provides a mock function with given fields: ctx, tx, filters
+func (_m *MockAssetRepository) GetAsset(ctx context.Context, tx *gorm.DB, filters entity.Filters) ([]*entity.Asset, error) {
+	ret := _m.Called(ctx, tx, filters)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAsset")
@@ -163,19 +212,19 @@ func (_m *MockAssetRepository) GetAsset(ctx context.Context, filters entity.Filt
 
 	var r0 []*entity.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Filters) ([]*entity.Asset, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, entity.Filters) ([]*entity.Asset, error)); ok {
+		return rf(ctx, tx, filters)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Filters) []*entity.Asset); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, entity.Filters) []*entity.Asset); ok {
+		r0 = rf(ctx, tx, filters)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entity.Filters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, entity.Filters) error); ok {
+		r1 = rf(ctx, tx, filters)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -190,16 +239,15 @@ type MockAssetRepository_GetAsset_Call struct {
 
 // GetAsset is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters asset.Filters
-func (_e *MockAssetRepository_Expecter) GetAsset(ctx interface{},
-	filters interface{}) *MockAssetRepository_GetAsset_Call {
-	return &MockAssetRepository_GetAsset_Call{Call: _e.mock.On("GetAsset", ctx, filters)}
+//   - tx *gorm.DB
+//   - filters entity.Filters
+func (_e *MockAssetRepository_Expecter) GetAsset(ctx interface{}, tx interface{}, filters interface{}) *MockAssetRepository_GetAsset_Call {
+	return &MockAssetRepository_GetAsset_Call{Call: _e.mock.On("GetAsset", ctx, tx, filters)}
 }
 
-func (_c *MockAssetRepository_GetAsset_Call) Run(run func(ctx context.Context,
-	filters entity.Filters)) *MockAssetRepository_GetAsset_Call {
+func (_c *MockAssetRepository_GetAsset_Call) Run(run func(ctx context.Context, tx *gorm.DB, filters entity.Filters)) *MockAssetRepository_GetAsset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.Filters))
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(entity.Filters))
 	})
 	return _c
 }
@@ -209,8 +257,54 @@ func (_c *MockAssetRepository_GetAsset_Call) Return(_a0 []*entity.Asset, _a1 err
 	return _c
 }
 
-func (_c *MockAssetRepository_GetAsset_Call) RunAndReturn(run func(context.Context, entity.Filters) ([]*entity.Asset,
-	error)) *MockAssetRepository_GetAsset_Call {
+func (_c *MockAssetRepository_GetAsset_Call) RunAndReturn(run func(context.Context, *gorm.DB, entity.Filters) ([]*entity.Asset, error)) *MockAssetRepository_GetAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockAssetRepository) InTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*gorm.DB) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssetRepository_InTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InTransaction'
+type MockAssetRepository_InTransaction_Call struct {
+	*mock.Call
+}
+
+// InTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(*gorm.DB) error
+func (_e *MockAssetRepository_Expecter) InTransaction(ctx interface{}, fn interface{}) *MockAssetRepository_InTransaction_Call {
+	return &MockAssetRepository_InTransaction_Call{Call: _e.mock.On("InTransaction", ctx, fn)}
+}
+
+func (_c *MockAssetRepository_InTransaction_Call) Run(run func(ctx context.Context, fn func(*gorm.DB) error)) *MockAssetRepository_InTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(*gorm.DB) error))
+	})
+	return _c
+}
+
+func (_c *MockAssetRepository_InTransaction_Call) Return(_a0 error) *MockAssetRepository_InTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssetRepository_InTransaction_Call) RunAndReturn(run func(context.Context, func(*gorm.DB) error) error) *MockAssetRepository_InTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -242,13 +336,11 @@ type MockAssetRepository_UpdateAsset_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - item *entity.Asset
-func (_e *MockAssetRepository_Expecter) UpdateAsset(ctx interface{}, tx interface{},
-	item interface{}) *MockAssetRepository_UpdateAsset_Call {
+func (_e *MockAssetRepository_Expecter) UpdateAsset(ctx interface{}, tx interface{}, item interface{}) *MockAssetRepository_UpdateAsset_Call {
 	return &MockAssetRepository_UpdateAsset_Call{Call: _e.mock.On("UpdateAsset", ctx, tx, item)}
 }
 
-func (_c *MockAssetRepository_UpdateAsset_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	item *entity.Asset)) *MockAssetRepository_UpdateAsset_Call {
+func (_c *MockAssetRepository_UpdateAsset_Call) Run(run func(ctx context.Context, tx *gorm.DB, item *entity.Asset)) *MockAssetRepository_UpdateAsset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Asset))
 	})
@@ -260,8 +352,7 @@ func (_c *MockAssetRepository_UpdateAsset_Call) Return(_a0 error) *MockAssetRepo
 	return _c
 }
 
-func (_c *MockAssetRepository_UpdateAsset_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Asset) error) *MockAssetRepository_UpdateAsset_Call {
+func (_c *MockAssetRepository_UpdateAsset_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Asset) error) *MockAssetRepository_UpdateAsset_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -305,13 +396,11 @@ type MockAssetRepository_Withdraw_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - _a2 *entity.Asset
-func (_e *MockAssetRepository_Expecter) Withdraw(ctx interface{}, tx interface{},
-	_a2 interface{}) *MockAssetRepository_Withdraw_Call {
+func (_e *MockAssetRepository_Expecter) Withdraw(ctx interface{}, tx interface{}, _a2 interface{}) *MockAssetRepository_Withdraw_Call {
 	return &MockAssetRepository_Withdraw_Call{Call: _e.mock.On("Withdraw", ctx, tx, _a2)}
 }
 
-func (_c *MockAssetRepository_Withdraw_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	_a2 *entity.Asset)) *MockAssetRepository_Withdraw_Call {
+func (_c *MockAssetRepository_Withdraw_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *entity.Asset)) *MockAssetRepository_Withdraw_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Asset))
 	})
@@ -323,8 +412,7 @@ func (_c *MockAssetRepository_Withdraw_Call) Return(_a0 *entity.Asset, _a1 error
 	return _c
 }
 
-func (_c *MockAssetRepository_Withdraw_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Asset) (*entity.Asset, error)) *MockAssetRepository_Withdraw_Call {
+func (_c *MockAssetRepository_Withdraw_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Asset) (*entity.Asset, error)) *MockAssetRepository_Withdraw_Call {
 	_c.Call.Return(run)
 	return _c
 }
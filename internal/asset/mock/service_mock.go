@@ -5,7 +5,10 @@ package mock
 import (
 	context "context"
 
+	common "github.com/safayildirim/asset-management-service/internal/common"
+
 	entity "github.com/safayildirim/asset-management-service/internal/asset/entity"
+
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
@@ -146,24 +149,83 @@ func (_c *MockAssetService_Deposit_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetAsset provides a mock function with given fields: ctx, id
+func (_m *MockAssetService) GetAsset(ctx context.Context, id uint) (*entity.Asset, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsset")
+	}
+
+	var r0 *entity.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entity.Asset, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entity.Asset); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssetService_GetAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAsset'
+type MockAssetService_GetAsset_Call struct {
+	*mock.Call
+}
+
+// GetAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockAssetService_Expecter) GetAsset(ctx interface{}, id interface{}) *MockAssetService_GetAsset_Call {
+	return &MockAssetService_GetAsset_Call{Call: _e.mock.On("GetAsset", ctx, id)}
+}
+
+func (_c *MockAssetService_GetAsset_Call) Run(run func(ctx context.Context, id uint)) *MockAssetService_GetAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockAssetService_GetAsset_Call) Return(_a0 *entity.Asset, _a1 error) *MockAssetService_GetAsset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssetService_GetAsset_Call) RunAndReturn(run func(context.Context, uint) (*entity.Asset, error)) *MockAssetService_GetAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAssets provides a mock function with given fields: ctx, _a1
-func (_m *MockAssetService) GetAssets(ctx context.Context, _a1 *request.GetAssetsParams) ([]*entity.Asset, error) {
+func (_m *MockAssetService) GetAssets(ctx context.Context, _a1 *request.GetAssetsParams) (*common.Page[*entity.Asset], error) {
 	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAssets")
 	}
 
-	var r0 []*entity.Asset
+	var r0 *common.Page[*entity.Asset]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *request.GetAssetsParams) ([]*entity.Asset, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetAssetsParams) (*common.Page[*entity.Asset], error)); ok {
 		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *request.GetAssetsParams) []*entity.Asset); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetAssetsParams) *common.Page[*entity.Asset]); ok {
 		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entity.Asset)
+			r0 = ret.Get(0).(*common.Page[*entity.Asset])
 		}
 	}
 
@@ -195,12 +257,180 @@ func (_c *MockAssetService_GetAssets_Call) Run(run func(ctx context.Context, _a1
 	return _c
 }
 
-func (_c *MockAssetService_GetAssets_Call) Return(_a0 []*entity.Asset, _a1 error) *MockAssetService_GetAssets_Call {
+func (_c *MockAssetService_GetAssets_Call) Return(_a0 *common.Page[*entity.Asset], _a1 error) *MockAssetService_GetAssets_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssetService_GetAssets_Call) RunAndReturn(run func(context.Context, *request.GetAssetsParams) ([]*entity.Asset, error)) *MockAssetService_GetAssets_Call {
+func (_c *MockAssetService_GetAssets_Call) RunAndReturn(run func(context.Context, *request.GetAssetsParams) (*common.Page[*entity.Asset], error)) *MockAssetService_GetAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hold provides a mock function with given fields: ctx, tx, _a2
+func (_m *MockAssetService) Hold(ctx context.Context, tx *gorm.DB, _a2 *request.HoldRequest) (*entity.Asset, error) {
+	ret := _m.Called(ctx, tx, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hold")
+	}
+
+	var r0 *entity.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *request.HoldRequest) (*entity.Asset, error)); ok {
+		return rf(ctx, tx, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *request.HoldRequest) *entity.Asset); ok {
+		r0 = rf(ctx, tx, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *request.HoldRequest) error); ok {
+		r1 = rf(ctx, tx, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssetService_Hold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hold'
+type MockAssetService_Hold_Call struct {
+	*mock.Call
+}
+
+// Hold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - _a2 *request.HoldRequest
+func (_e *MockAssetService_Expecter) Hold(ctx interface{}, tx interface{}, _a2 interface{}) *MockAssetService_Hold_Call {
+	return &MockAssetService_Hold_Call{Call: _e.mock.On("Hold", ctx, tx, _a2)}
+}
+
+func (_c *MockAssetService_Hold_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *request.HoldRequest)) *MockAssetService_Hold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*request.HoldRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssetService_Hold_Call) Return(_a0 *entity.Asset, _a1 error) *MockAssetService_Hold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssetService_Hold_Call) RunAndReturn(run func(context.Context, *gorm.DB, *request.HoldRequest) (*entity.Asset, error)) *MockAssetService_Hold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseHold provides a mock function with given fields: ctx, tx, _a2
+func (_m *MockAssetService) ReleaseHold(ctx context.Context, tx *gorm.DB, _a2 *request.HoldRequest) (*entity.Asset, error) {
+	ret := _m.Called(ctx, tx, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseHold")
+	}
+
+	var r0 *entity.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *request.HoldRequest) (*entity.Asset, error)); ok {
+		return rf(ctx, tx, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *request.HoldRequest) *entity.Asset); ok {
+		r0 = rf(ctx, tx, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *request.HoldRequest) error); ok {
+		r1 = rf(ctx, tx, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssetService_ReleaseHold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseHold'
+type MockAssetService_ReleaseHold_Call struct {
+	*mock.Call
+}
+
+// ReleaseHold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - _a2 *request.HoldRequest
+func (_e *MockAssetService_Expecter) ReleaseHold(ctx interface{}, tx interface{}, _a2 interface{}) *MockAssetService_ReleaseHold_Call {
+	return &MockAssetService_ReleaseHold_Call{Call: _e.mock.On("ReleaseHold", ctx, tx, _a2)}
+}
+
+func (_c *MockAssetService_ReleaseHold_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *request.HoldRequest)) *MockAssetService_ReleaseHold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*request.HoldRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssetService_ReleaseHold_Call) Return(_a0 *entity.Asset, _a1 error) *MockAssetService_ReleaseHold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssetService_ReleaseHold_Call) RunAndReturn(run func(context.Context, *gorm.DB, *request.HoldRequest) (*entity.Asset, error)) *MockAssetService_ReleaseHold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transfer provides a mock function with given fields: ctx, tx, _a2
+func (_m *MockAssetService) Transfer(ctx context.Context, tx *gorm.DB, _a2 *request.TransferRequest) error {
+	ret := _m.Called(ctx, tx, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *request.TransferRequest) error); ok {
+		r0 = rf(ctx, tx, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssetService_Transfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transfer'
+type MockAssetService_Transfer_Call struct {
+	*mock.Call
+}
+
+// Transfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - _a2 *request.TransferRequest
+func (_e *MockAssetService_Expecter) Transfer(ctx interface{}, tx interface{}, _a2 interface{}) *MockAssetService_Transfer_Call {
+	return &MockAssetService_Transfer_Call{Call: _e.mock.On("Transfer", ctx, tx, _a2)}
+}
+
+func (_c *MockAssetService_Transfer_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *request.TransferRequest)) *MockAssetService_Transfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*request.TransferRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssetService_Transfer_Call) Return(_a0 error) *MockAssetService_Transfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssetService_Transfer_Call) RunAndReturn(run func(context.Context, *gorm.DB, *request.TransferRequest) error) *MockAssetService_Transfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
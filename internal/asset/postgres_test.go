@@ -0,0 +1,204 @@
+//go:build integration
+
+package asset
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset/entity"
+	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	catalogentity "github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/internal/ledger"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/safayildirim/asset-management-service/pkg/db"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"sync"
+	"testing"
+	"time"
+)
+
+// The tests in this file run against a real Postgres database, so that the unique constraint, the optimistic
+// versioning of UpdateAsset and the transaction semantics are those of production. They are built with the
+// integration tag and connect to the database configured by the PG_* variables, e.g. the one of docker-compose:
+//
+//	docker-compose up -d postgres && make test-integration
+
+// newPostgresService connects to the configured database, applies the migrations and builds a service on the SQL
+// repositories. The wallet it returns is unique to the test, and its rows are removed afterwards.
+func newPostgresService(t *testing.T, symbol string) (Service, *gorm.DB, uint) {
+	t.Helper()
+
+	conn, err := db.NewConnection(config.New().Postgres)
+	require.NoError(t, err)
+
+	// A wallet no other run uses, so that concurrent runs and leftovers of failed runs do not interfere
+	walletID := uint(time.Now().UnixNano() % 1_000_000_000)
+
+	t.Cleanup(func() {
+		conn.Exec("DELETE FROM ledger_entries WHERE wallet_id = ?", walletID)
+		conn.Exec("DELETE FROM outbox_events WHERE (payload->>'wallet_id')::bigint = ?", walletID)
+		conn.Exec("DELETE FROM assets WHERE wallet_id = ?", walletID)
+		if sqlDB, err := conn.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	mockCatalogService := catalogmock.NewMockCatalogService(t)
+	mockCatalogService.EXPECT().ValidateAmount(mock.Anything, symbol, mock.Anything).
+		Return(&catalogentity.AssetDefinition{Symbol: symbol, Decimals: 8, Enabled: true}, nil)
+	mockWalletClient := walletmock.NewMockWalletClient(t)
+	mockWalletClient.EXPECT().GetWallet(mock.Anything, walletID).Return(&walletentity.Wallet{ID: walletID}, nil)
+
+	s := NewService(NewRepository(conn), ledger.NewRepository(conn), outbox.NewRepository(conn), mockCatalogService,
+		mockWalletClient, walletpkg.NewRules(nil))
+
+	return s, conn, walletID
+}
+
+func TestService_Postgres_ConcurrentFirstDeposits(t *testing.T) {
+	const workers = 20
+
+	s, conn, walletID := newPostgresService(t, "BTC")
+
+	amount := decimal.RequireFromString("0.5")
+
+	var (
+		mu        sync.Mutex
+		deposited = decimal.Zero
+		wg        sync.WaitGroup
+	)
+
+	// Every worker races to create the asset with its first deposit; none of them may fail on the unique constraint
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.Deposit(context.Background(), nil, &request.CreateDepositRequest{
+				WalletID: walletID, Name: "BTC", Amount: amount,
+			})
+			if err != nil {
+				assert.ErrorIs(t, err, ErrConcurrentUpdate)
+				return
+			}
+			mu.Lock()
+			deposited = deposited.Add(amount)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, deposited.IsPositive())
+
+	var assets []*entity.Asset
+	err := conn.Where("wallet_id = ? AND name = ?", walletID, "BTC").Find(&assets).Error
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.True(t, deposited.Equal(assets[0].Amount), "expected balance %s, got %s", deposited, assets[0].Amount)
+
+	assertLedgerReplays(t, conn, walletID, assets[0].Amount)
+}
+
+func TestService_Postgres_ConcurrentDepositsAndWithdrawals(t *testing.T) {
+	const (
+		workers    = 20
+		operations = 10
+	)
+
+	s, conn, walletID := newPostgresService(t, "ETH")
+
+	initial := decimal.RequireFromString("10")
+	_, err := s.Deposit(context.Background(), nil, &request.CreateDepositRequest{
+		WalletID: walletID, Name: "ETH", Amount: initial,
+	})
+	require.NoError(t, err)
+
+	depositAmount := decimal.RequireFromString("0.1")
+	withdrawAmount := decimal.RequireFromString("0.3")
+
+	var (
+		mu        sync.Mutex
+		deposited = decimal.Zero
+		withdrawn = decimal.Zero
+		wg        sync.WaitGroup
+	)
+
+	// Every worker alternates deposits and withdrawals against the same row
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < operations; i++ {
+				if (worker+i)%2 == 0 {
+					_, err := s.Deposit(context.Background(), nil, &request.CreateDepositRequest{
+						WalletID: walletID, Name: "ETH", Amount: depositAmount,
+					})
+					if err != nil {
+						assert.ErrorIs(t, err, ErrConcurrentUpdate)
+						continue
+					}
+					mu.Lock()
+					deposited = deposited.Add(depositAmount)
+					mu.Unlock()
+					continue
+				}
+
+				_, err := s.Withdraw(context.Background(), nil, &request.CreateWithdrawRequest{
+					WalletID: walletID, Name: "ETH", Amount: withdrawAmount,
+				})
+				mu.Lock()
+				switch {
+				case err == nil:
+					withdrawn = withdrawn.Add(withdrawAmount)
+				case errors.Is(err, ErrInsufficientBalance):
+				default:
+					assert.ErrorIs(t, err, ErrConcurrentUpdate)
+				}
+				mu.Unlock()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var assets []*entity.Asset
+	err = conn.Where("wallet_id = ? AND name = ?", walletID, "ETH").Find(&assets).Error
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+
+	// The stored balance must reflect exactly the operations that reported success
+	expected := initial.Add(deposited).Sub(withdrawn)
+	assert.True(t, expected.Equal(assets[0].Amount), "expected balance %s, got %s", expected, assets[0].Amount)
+	assert.False(t, assets[0].Amount.IsNegative())
+
+	assertLedgerReplays(t, conn, walletID, assets[0].Amount)
+}
+
+// assertLedgerReplays checks that replaying the committed ledger entries of the wallet, which hold every change since
+// the asset was created, explains its stored balance exactly
+func assertLedgerReplays(t *testing.T, conn *gorm.DB, walletID uint, balance decimal.Decimal) {
+	t.Helper()
+
+	entries, err := ledger.NewRepository(conn).GetEntries(context.Background(), ledgerentity.Filters{
+		WalletID: []uint{walletID},
+	})
+	require.NoError(t, err)
+
+	replayed := decimal.Zero
+	for _, e := range entries {
+		if e.Direction == ledgerentity.Credit {
+			replayed = replayed.Add(e.Amount)
+		} else {
+			replayed = replayed.Sub(e.Amount)
+		}
+	}
+	assert.True(t, replayed.Equal(balance), "ledger replays to %s, balance is %s", replayed, balance)
+}
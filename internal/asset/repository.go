@@ -3,16 +3,29 @@ package asset
 import (
 	"context"
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"gopkg.in/guregu/null.v3"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strings"
+	"time"
 )
 
+// sortColumns maps the fields assets can be sorted by to their columns
+var sortColumns = map[string]common.SortColumn{
+	"id":         {Name: "id"},
+	"created_at": {Name: "created_at", Type: "timestamp"},
+	"amount":     {Name: "amount", Type: "numeric"},
+}
+
 type Repository interface {
 	Deposit(ctx context.Context, tx *gorm.DB, entity *entity.Asset) (*entity.Asset, error)
 	Withdraw(ctx context.Context, tx *gorm.DB, entity *entity.Asset) (*entity.Asset, error)
-	GetAsset(ctx context.Context, filters entity.Filters) ([]*entity.Asset, error)
+	GetAsset(ctx context.Context, tx *gorm.DB, filters entity.Filters) ([]*entity.Asset, error)
 	CreateAsset(ctx context.Context, tx *gorm.DB, item *entity.Asset) (*entity.Asset, error)
+	CreateAssetIfMissing(ctx context.Context, tx *gorm.DB, item *entity.Asset) (bool, error)
 	UpdateAsset(ctx context.Context, tx *gorm.DB, item *entity.Asset) error
+	InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error
 }
 
 type repository struct {
@@ -66,10 +79,32 @@ func (r *repository) CreateAsset(ctx context.Context, tx *gorm.DB, item *entity.
 	return item, nil
 }
 
-func (r *repository) GetAsset(ctx context.Context, filters entity.Filters) ([]*entity.Asset, error) {
+// CreateAssetIfMissing inserts an asset unless the wallet already holds an asset of the same name, and reports whether
+// it was inserted. Unlike CreateAsset, a conflicting row raises no unique violation, which would abort the surrounding
+// transaction; when a concurrent transaction is inserting the same asset, the insert waits for it to finish.
+func (r *repository) CreateAssetIfMissing(ctx context.Context, tx *gorm.DB, item *entity.Asset) (bool, error) {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	result := db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "wallet_id"}, {Name: "name"}}, DoNothing: true}).
+		Create(item)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *repository) GetAsset(ctx context.Context, tx *gorm.DB, filters entity.Filters) ([]*entity.Asset, error) {
 	var assets []*entity.Asset
 
-	query := r.db.Model(&entity.Asset{})
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	query := db.WithContext(ctx).Model(&entity.Asset{})
 
 	if len(filters.ID) > 0 {
 		query = query.Where("id IN ?", filters.ID)
@@ -80,6 +115,15 @@ func (r *repository) GetAsset(ctx context.Context, filters entity.Filters) ([]*e
 	if len(filters.WalletID) > 0 {
 		query = query.Where("wallet_id IN ?", filters.WalletID)
 	}
+	if filters.MinAmount != nil {
+		query = query.Where("amount >= ?", *filters.MinAmount)
+	}
+	if filters.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filters.MaxAmount)
+	}
+	if filters.Page != nil {
+		query = common.Paginate(query, filters.Page, sortColumns)
+	}
 
 	err := query.Find(&assets).Error
 	if err != nil {
@@ -89,15 +133,49 @@ func (r *repository) GetAsset(ctx context.Context, filters entity.Filters) ([]*e
 	return assets, nil
 }
 
+// UpdateAsset persists the asset balance and held amount using optimistic versioning. The update only applies when the
+// stored version still matches the one that was read; otherwise ErrConcurrentUpdate is returned and nothing is written.
 func (r *repository) UpdateAsset(ctx context.Context, tx *gorm.DB, item *entity.Asset) error {
 	db := tx
 	if db == nil {
 		db = r.db
 	}
-	err := db.WithContext(ctx).Save(item).Error
-	if err != nil {
-		return err
+
+	now := time.Now()
+	result := db.WithContext(ctx).Model(&entity.Asset{}).
+		Where("id = ? AND version = ?", item.ID, item.Version).
+		Updates(map[string]interface{}{
+			"amount":     item.Amount,
+			"held":       item.Held,
+			"version":    item.Version + 1,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrConcurrentUpdate
 	}
 
+	item.Version++
+	item.UpdatedAt = null.TimeFrom(now)
+
 	return nil
 }
+
+func (r *repository) InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	tx := r.db.WithContext(ctx).Begin() // Start a transaction
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	// Execute the transactional logic
+	if err := fn(tx); err != nil {
+		tx.Rollback() // Rollback on error
+		return err
+	}
+
+	// Commit if everything is successful
+	return tx.Commit().Error
+}
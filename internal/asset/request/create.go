@@ -3,18 +3,21 @@ package request
 import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
 )
 
 type CreateAssetRequest struct {
-	WalletID uint    `json:"wallet_id"`
-	Name     string  `json:"name"`
-	Amount   float64 `json:"amount"`
+	WalletID uint            `json:"wallet_id"`
+	Name     string          `json:"name"`
+	Amount   decimal.Decimal `json:"amount"`
 }
 
 func (r CreateAssetRequest) Validate() error {
 	fields := []*validation.FieldRules{
 		validation.Field(&r.WalletID, validation.Required),
 		validation.Field(&r.Name, validation.Required),
+		validation.Field(&r.Amount, common.NonNegativeAmount),
 	}
 
 	return errors.Wrap(validation.ValidateStruct(&r, fields...), "deposit create validation error")
@@ -1,7 +1,45 @@
 package request
 
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+// SortFields lists the fields assets can be sorted by
+var SortFields = []string{"id", "created_at", "amount"}
+
 type GetAssetsParams struct {
-	ID       []uint   `json:"id" schema:"id"`
-	WalletID []uint   `json:"wallet_id" schema:"wallet_id"`
-	Name     []string `json:"name" schema:"name"`
+	ID        []uint           `json:"id" schema:"id"`
+	WalletID  []uint           `json:"wallet_id" schema:"wallet_id"`
+	Name      []string         `json:"name" schema:"name"`
+	MinAmount *decimal.Decimal `json:"min_amount" schema:"min_amount"`
+	MaxAmount *decimal.Decimal `json:"max_amount" schema:"max_amount"`
+	Limit     int              `json:"limit" schema:"limit"`
+	Sort      string           `json:"sort" schema:"sort"`
+	Cursor    string           `json:"cursor" schema:"cursor"`
+}
+
+func (r GetAssetsParams) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.MinAmount, common.NonNegativeAmount),
+		validation.Field(&r.MaxAmount, common.NonNegativeAmount, validation.By(func(value interface{}) error {
+			if r.MinAmount != nil && r.MaxAmount != nil && r.MaxAmount.LessThan(*r.MinAmount) {
+				return errors.New("must not be lower than min_amount")
+			}
+			return nil
+		})),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(common.MaxPageLimit)),
+		validation.Field(&r.Sort, common.SortRule(SortFields...)),
+		validation.Field(&r.Cursor, validation.By(func(value interface{}) error {
+			if r.Cursor == "" {
+				return nil
+			}
+			_, err := common.DecodeCursor(r.Cursor, common.ParseSort(r.Sort))
+			return err
+		})),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "asset query validation error")
 }
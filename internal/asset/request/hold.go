@@ -0,0 +1,12 @@
+package request
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// HoldRequest reserves or releases part of a wallet's balance for a scheduled transfer. It is only used internally.
+type HoldRequest struct {
+	WalletID uint
+	Name     string
+	Amount   decimal.Decimal
+}
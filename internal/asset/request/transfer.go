@@ -0,0 +1,24 @@
+package request
+
+import (
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/shopspring/decimal"
+)
+
+// TransferRequest moves part of a wallet's balance to another wallet. It is only used internally.
+type TransferRequest struct {
+	SourceWalletID      uint
+	DestinationWalletID uint
+	Name                string
+	Amount              decimal.Decimal
+	// Reference links the resulting ledger entries and events to the originating operation
+	Reference ledgerentity.Reference
+	// FromHold takes the amount from funds held on the source wallet for the originating operation instead of its
+	// available balance
+	FromHold bool
+	// SourceWallet and DestinationWallet are the wallets as already looked up and validated by the caller, e.g. for all
+	// legs of a batch at once; they are looked up when not set
+	SourceWallet      *walletentity.Wallet
+	DestinationWallet *walletentity.Wallet
+}
@@ -5,46 +5,146 @@ import (
 	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
 	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/ledger"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
 	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"time"
 )
 
+// maxUpdateAttempts bounds how often a balance update is retried after losing an optimistic locking race
+const maxUpdateAttempts = 10
+
 type Service interface {
 	CreateAsset(ctx context.Context, tx *gorm.DB, request *request.CreateAssetRequest) (*entity.Asset, error)
-	GetAssets(ctx context.Context, request *request.GetAssetsParams) ([]*entity.Asset, error)
+	GetAssets(ctx context.Context, request *request.GetAssetsParams) (*common.Page[*entity.Asset], error)
+	GetAsset(ctx context.Context, id uint) (*entity.Asset, error)
 	Deposit(ctx context.Context, tx *gorm.DB, request *request.CreateDepositRequest) (*entity.Asset, error)
 	Withdraw(ctx context.Context, tx *gorm.DB, request *request.CreateWithdrawRequest) (*entity.Asset, error)
+	Hold(ctx context.Context, tx *gorm.DB, request *request.HoldRequest) (*entity.Asset, error)
+	ReleaseHold(ctx context.Context, tx *gorm.DB, request *request.HoldRequest) (*entity.Asset, error)
+	Transfer(ctx context.Context, tx *gorm.DB, request *request.TransferRequest) error
 }
 
 type service struct {
-	assetRepository Repository
-	walletClient    wallet.Client
+	assetRepository  Repository
+	ledgerRepository ledger.Repository
+	outboxRepository outbox.Repository
+	catalogService   catalog.Service
+	walletClient     wallet.Client
+	walletRules      *wallet.Rules
 }
 
-func NewService(assetRepository Repository, walletClient wallet.Client) Service {
-	return &service{assetRepository: assetRepository, walletClient: walletClient}
+func NewService(assetRepository Repository, ledgerRepository ledger.Repository, outboxRepository outbox.Repository,
+	catalogService catalog.Service, walletClient wallet.Client, walletRules *wallet.Rules) Service {
+	return &service{assetRepository: assetRepository, ledgerRepository: ledgerRepository,
+		outboxRepository: outboxRepository, catalogService: catalogService, walletClient: walletClient,
+		walletRules: walletRules}
 }
 
 func (s *service) CreateAsset(ctx context.Context, tx *gorm.DB, request *request.CreateAssetRequest) (*entity.Asset,
 	error) {
-	item := entity.Asset{
-		WalletID: request.WalletID,
-		Name:     request.Name,
-		Amount:   request.Amount,
+	// Resolve the canonical asset symbol and check the initial amount against its precision
+	definition, err := s.catalogService.ValidateAmount(ctx, request.Name, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetEntity *entity.Asset
+
+	// Create the asset and its AssetCreated event atomically
+	err = s.inTransaction(ctx, tx, func(tx *gorm.DB) error {
+		var err error
+		assetEntity, err = s.assetRepository.CreateAsset(ctx, tx, &entity.Asset{
+			WalletID: request.WalletID,
+			Name:     definition.Symbol,
+			Amount:   request.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, outboxentity.AssetCreated, assetEntity, request.Amount,
+			ledgerentity.Reference{})
+	})
+	if err != nil {
+		return nil, err
 	}
-	return s.assetRepository.CreateAsset(ctx, tx, &item)
+
+	return assetEntity, nil
 }
 
-func (s *service) GetAssets(ctx context.Context, request *request.GetAssetsParams) ([]*entity.Asset, error) {
+// GetAssets fetches a page of the assets matching the filter criteria of the request.
+//
+// Parameters:
+// - ctx: Context for managing request lifecycle and cancellation.
+// - request: Request object containing the filter criteria, including:
+//   - ID, WalletID, Name: Lists of asset IDs, wallet IDs and asset names to filter by.
+//   - MinAmount, MaxAmount: Optional inclusive bounds of the balance.
+//   - Limit, Sort, Cursor: The page size, the sort order and the cursor of the page to fetch.
+//
+// Returns:
+// - The page of matching assets, along with the cursor of the next page if there is one.
+// - An error if the cursor is invalid or the repository query fails.
+func (s *service) GetAssets(ctx context.Context, request *request.GetAssetsParams) (*common.Page[*entity.Asset],
+	error) {
+	page, err := common.NewPageQuery(request.Limit, request.Sort, request.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(request.Name))
+	for _, name := range request.Name {
+		names = append(names, catalog.NormalizeSymbol(name))
+	}
+
 	filters := entity.Filters{
-		ID:       request.ID,
-		Name:     request.Name,
-		WalletID: request.WalletID,
+		ID:        request.ID,
+		Name:      names,
+		WalletID:  request.WalletID,
+		MinAmount: request.MinAmount,
+		MaxAmount: request.MaxAmount,
+		Page:      page,
 	}
-	return s.assetRepository.GetAsset(ctx, filters)
+	assets, err := s.assetRepository.GetAsset(ctx, nil, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.NewPage(assets, page, func(item *entity.Asset) (string, uint) {
+		switch page.Sort.Field {
+		case "created_at":
+			return item.CreatedAt.Format(time.RFC3339Nano), item.ID
+		case "amount":
+			return item.Amount.String(), item.ID
+		}
+		return "", item.ID
+	}), nil
+}
+
+// GetAsset fetches a single asset by its ID, returning ErrAssetNotFound if it does not exist
+func (s *service) GetAsset(ctx context.Context, id uint) (*entity.Asset, error) {
+	assets, err := s.assetRepository.GetAsset(ctx, nil, entity.Filters{ID: []uint{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(assets) == 0 {
+		return nil, ErrAssetNotFound
+	}
+
+	return assets[0], nil
 }
 
-// Deposit adds the specified amount of an asset to the wallet.
+// Deposit adds the specified amount of an asset to the wallet, records the credit in the ledger and writes a Deposited
+// event to the outbox.
 //
 // Parameters:
 // - ctx: Context for managing request lifecycle and cancellation.
@@ -59,44 +159,44 @@ func (s *service) GetAssets(ctx context.Context, request *request.GetAssetsParam
 // - An error if any validation or persistence step fails.
 //
 // Errors:
-// - Returns an error if the wallet does not exist, or if asset retrieval or update fails.
+// - Returns an error if the asset is unknown, disabled or the amount exceeds its precision.
+// - Returns an error if the wallet does not exist, or if asset retrieval, update or the ledger write fails.
+// - wallet.ErrWalletDeleted: If the wallet was deleted in the wallet service.
+// - ErrConcurrentUpdate: If the balance kept changing concurrently after all retry attempts.
 func (s *service) Deposit(ctx context.Context, tx *gorm.DB, request *request.CreateDepositRequest) (*entity.Asset,
 	error) {
-	// Verify that the wallet exists using the wallet client
-	w, err := s.walletClient.GetWallet(ctx, request.WalletID)
+	// Resolve the canonical asset symbol and reject unknown, disabled or over-precise amounts
+	definition, err := s.catalogService.ValidateAmount(ctx, request.Name, request.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	var assetEntity *entity.Asset
-
-	// Fetch existing assets for the specified wallet and asset name
-	assets, err := s.assetRepository.GetAsset(ctx, entity.Filters{
-		Name:     []string{request.Name},
-		WalletID: []uint{request.WalletID},
-	})
+	// Verify that the wallet exists and was not deleted using the wallet client
+	w, err := s.activeWallet(ctx, request.WalletID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the asset exists for the wallet
-	if len(assets) == 0 {
-		assetEntity, err = s.assetRepository.CreateAsset(ctx, tx, &entity.Asset{
-			WalletID: w.ID,
-			Name:     request.Name,
-		})
+	var assetEntity *entity.Asset
+
+	// Apply the balance change, its ledger journal and its outbox event atomically
+	err = s.inTransaction(ctx, tx, func(tx *gorm.DB) error {
+		var err error
+		assetEntity, err = s.credit(ctx, tx, w.ID, definition.Symbol, request.Amount)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	} else {
-		assetEntity = assets[0]
-	}
 
-	// Increase the asset amount by the specified deposit value
-	assetEntity.Amount += request.Amount
+		// Record the credit to the wallet in the ledger
+		reference := ledgerentity.Reference{Type: ledgerentity.ReferenceDeposit}
+		err = s.ledgerRepository.CreateEntries(ctx, tx, ledgerentity.NewJournal(ledger.NewJournalID(), w.ID,
+			definition.Symbol, ledgerentity.Credit, request.Amount, assetEntity.Amount, reference))
+		if err != nil {
+			return err
+		}
 
-	// Update the asset in the repository
-	err = s.assetRepository.UpdateAsset(ctx, tx, assetEntity)
+		return s.recordEvent(ctx, tx, outboxentity.Deposited, assetEntity, request.Amount, reference)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +205,8 @@ func (s *service) Deposit(ctx context.Context, tx *gorm.DB, request *request.Cre
 	return assetEntity, nil
 }
 
-// Withdraw deducts the specified amount of an asset from the wallet.
+// Withdraw deducts the specified amount of an asset from the wallet, records the debit in the ledger and writes a
+// Withdrawn event to the outbox.
 //
 // Parameters:
 // - ctx: Context for managing request lifecycle and cancellation.
@@ -120,55 +221,404 @@ func (s *service) Deposit(ctx context.Context, tx *gorm.DB, request *request.Cre
 // - An error if any validation or persistence step fails.
 //
 // Errors:
-// - Returns an error if the wallet does not exist, if asset retrieval or update fails, or if the balance is insufficient.
+// - Returns an error if the asset is unknown, disabled or the amount exceeds its precision.
+// - Returns an error if the wallet does not exist, or if asset retrieval, update or the ledger write fails.
+// - wallet.ErrWalletDeleted: If the wallet was deleted in the wallet service.
+// - ErrInsufficientBalance: If the available balance, which excludes held funds, is lower than the requested amount.
+// - ErrConcurrentUpdate: If the balance kept changing concurrently after all retry attempts.
 func (s *service) Withdraw(ctx context.Context, tx *gorm.DB, request *request.CreateWithdrawRequest) (*entity.Asset,
 	error) {
-	// Verify that the wallet exists using the wallet client
-	w, err := s.walletClient.GetWallet(ctx, request.WalletID)
+	// Resolve the canonical asset symbol and reject unknown, disabled or over-precise amounts
+	definition, err := s.catalogService.ValidateAmount(ctx, request.Name, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify that the wallet exists and was not deleted using the wallet client
+	w, err := s.activeWallet(ctx, request.WalletID)
 	if err != nil {
 		return nil, err
 	}
 
 	var assetEntity *entity.Asset
 
-	// Fetch the asset associated with the specified wallet and asset name
-	assets, err := s.assetRepository.GetAsset(ctx, entity.Filters{
-		Name:     []string{request.Name},
-		WalletID: []uint{request.WalletID},
+	// Apply the balance change, its ledger journal and its outbox event atomically
+	err = s.inTransaction(ctx, tx, func(tx *gorm.DB) error {
+		var err error
+		assetEntity, err = s.debit(ctx, tx, w.ID, definition.Symbol, request.Amount, false)
+		if err != nil {
+			return err
+		}
+
+		// Record the debit from the wallet in the ledger
+		reference := ledgerentity.Reference{Type: ledgerentity.ReferenceWithdrawal}
+		err = s.ledgerRepository.CreateEntries(ctx, tx, ledgerentity.NewJournal(ledger.NewJournalID(), w.ID,
+			definition.Symbol, ledgerentity.Debit, request.Amount, assetEntity.Amount, reference))
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, outboxentity.Withdrawn, assetEntity, request.Amount, reference)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the asset exists for the wallet
-	if len(assets) == 0 {
-		// Create a new asset if it does not exist (with a zero balance)
-		assetEntity, err = s.assetRepository.CreateAsset(ctx, tx, &entity.Asset{
-			WalletID: w.ID,
-			Name:     request.Name,
+	// Return the updated asset
+	return assetEntity, nil
+}
+
+// Transfer moves the specified amount of an asset from one wallet to another, records both sides in the ledger as a
+// single journal and writes a Withdrawn and a Deposited event to the outbox.
+//
+// Parameters:
+// - ctx: Context for managing request lifecycle and cancellation.
+// - tx: Optional database transaction for atomic operations.
+// - request: Request object containing the transfer details, including:
+//   - SourceWalletID: The ID of the wallet to transfer from.
+//   - DestinationWalletID: The ID of the wallet to transfer to.
+//   - Name: The name of the asset being transferred.
+//   - Amount: The amount to transfer.
+//   - FromHold: Whether the amount is taken from funds previously held for the operation.
+//   - SourceWallet, DestinationWallet: The wallets if the caller already looked them up and validated them.
+//
+// Returns:
+// - An error if any validation or persistence step fails.
+//
+// Errors:
+// - Returns an error if the asset is unknown, disabled or the amount exceeds its precision.
+// - Returns an error if either wallet does not exist, or if asset retrieval, update or the ledger write fails.
+// - wallet.ErrWalletDeleted: If either wallet was deleted in the wallet service.
+// - ErrInsufficientBalance: If the available balance of the source wallet is lower than the requested amount.
+// - ErrInsufficientHold: If the amount is taken from held funds and fewer funds are held.
+// - ErrConcurrentUpdate: If a balance kept changing concurrently after all retry attempts.
+func (s *service) Transfer(ctx context.Context, tx *gorm.DB, request *request.TransferRequest) error {
+	// Resolve the canonical asset symbol and reject unknown, disabled or over-precise amounts
+	definition, err := s.catalogService.ValidateAmount(ctx, request.Name, request.Amount)
+	if err != nil {
+		return err
+	}
+
+	// Look up the wallets unless the caller already did
+	source := request.SourceWallet
+	if source == nil {
+		source, err = s.activeWallet(ctx, request.SourceWalletID)
+		if err != nil {
+			return err
+		}
+	}
+
+	destination := request.DestinationWallet
+	if destination == nil {
+		destination, err = s.activeWallet(ctx, request.DestinationWalletID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Apply both balance changes, their ledger journal and their outbox events atomically
+	return s.inTransaction(ctx, tx, func(tx *gorm.DB) error {
+		sourceAsset, err := s.debit(ctx, tx, source.ID, definition.Symbol, request.Amount, request.FromHold)
+		if err != nil {
+			return err
+		}
+
+		destinationAsset, err := s.credit(ctx, tx, destination.ID, definition.Symbol, request.Amount)
+		if err != nil {
+			return err
+		}
+
+		// Book the debit of the source and the credit of the destination against each other
+		err = s.ledgerRepository.CreateEntries(ctx, tx, ledgerentity.NewTransferJournal(ledger.NewJournalID(),
+			source.ID, destination.ID, definition.Symbol, request.Amount, sourceAsset.Amount,
+			destinationAsset.Amount, request.Reference))
+		if err != nil {
+			return err
+		}
+
+		err = s.recordEvent(ctx, tx, outboxentity.Withdrawn, sourceAsset, request.Amount, request.Reference)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, outboxentity.Deposited, destinationAsset, request.Amount, request.Reference)
+	})
+}
+
+// Hold reserves part of the available balance of a wallet, typically for a scheduled transfer. Held funds still count
+// towards the balance but can no longer be withdrawn, except by a withdrawal made from the hold.
+//
+// Parameters:
+// - ctx: Context for managing request lifecycle and cancellation.
+// - tx: Optional database transaction for atomic operations.
+// - request: Request object containing the wallet ID, asset name and amount to hold.
+//
+// Returns:
+// - The updated asset entity after the hold was placed.
+// - An error if any validation or persistence step fails.
+//
+// Errors:
+// - Returns an error if the asset is unknown, disabled or the amount exceeds its precision.
+// - ErrInsufficientBalance: If the wallet holds no such asset or its available balance is lower than the amount.
+// - ErrConcurrentUpdate: If the balance kept changing concurrently after all retry attempts.
+func (s *service) Hold(ctx context.Context, tx *gorm.DB, request *request.HoldRequest) (*entity.Asset, error) {
+	return s.changeHold(ctx, tx, request, func(assetEntity *entity.Asset) error {
+		if assetEntity.Available().LessThan(request.Amount) {
+			return insufficientBalance(assetEntity, request.Amount)
+		}
+
+		assetEntity.Held = assetEntity.Held.Add(request.Amount)
+		return nil
+	})
+}
+
+// ReleaseHold returns previously held funds to the available balance of a wallet, e.g. when a scheduled transfer is
+// cancelled or fails.
+//
+// Parameters:
+// - ctx: Context for managing request lifecycle and cancellation.
+// - tx: Optional database transaction for atomic operations.
+// - request: Request object containing the wallet ID, asset name and amount to release.
+//
+// Returns:
+// - The updated asset entity after the hold was released.
+// - An error if any validation or persistence step fails.
+//
+// Errors:
+// - Returns an error if the asset is unknown, disabled or the amount exceeds its precision.
+// - ErrInsufficientHold: If fewer funds are held than the amount to release.
+// - ErrConcurrentUpdate: If the balance kept changing concurrently after all retry attempts.
+func (s *service) ReleaseHold(ctx context.Context, tx *gorm.DB, request *request.HoldRequest) (*entity.Asset,
+	error) {
+	return s.changeHold(ctx, tx, request, func(assetEntity *entity.Asset) error {
+		if assetEntity.Held.LessThan(request.Amount) {
+			return ErrInsufficientHold
+		}
+
+		assetEntity.Held = assetEntity.Held.Sub(request.Amount)
+		return nil
+	})
+}
+
+// changeHold applies a change to the held amount of a wallet's asset with the same optimistic retry as balance updates.
+// Holds do not move funds, so no ledger entries are written.
+func (s *service) changeHold(ctx context.Context, tx *gorm.DB, request *request.HoldRequest,
+	change func(assetEntity *entity.Asset) error) (*entity.Asset, error) {
+	// Resolve the canonical asset symbol
+	definition, err := s.catalogService.ValidateAmount(ctx, request.Name, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetEntity *entity.Asset
+
+	err = s.inTransaction(ctx, tx, func(tx *gorm.DB) error {
+		return retryOnConflict(func() error {
+			assets, err := s.assetRepository.GetAsset(ctx, tx, entity.Filters{
+				Name:     []string{definition.Symbol},
+				WalletID: []uint{request.WalletID},
+			})
+			if err != nil {
+				return err
+			}
+
+			// A wallet without the asset has nothing to hold
+			if len(assets) == 0 {
+				return ErrInsufficientBalance
+			}
+			assetEntity = assets[0]
+
+			err = change(assetEntity)
+			if err != nil {
+				return err
+			}
+
+			return s.assetRepository.UpdateAsset(ctx, tx, assetEntity)
 		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assetEntity, nil
+}
+
+// activeWallet looks up a wallet and checks that it can still take part in balance changes
+func (s *service) activeWallet(ctx context.Context, walletID uint) (*walletentity.Wallet, error) {
+	w, err := s.walletClient.GetWallet(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.walletRules.CheckActive(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// credit increases the balance of an asset of a wallet, creating the asset if it does not exist yet
+func (s *service) credit(ctx context.Context, tx *gorm.DB, walletID uint, symbol string,
+	amount decimal.Decimal) (*entity.Asset, error) {
+	var assetEntity *entity.Asset
+
+	// Read, modify and write the balance, starting over whenever another writer got there first
+	err := retryOnConflict(func() error {
+		var err error
+		assetEntity, err = s.getOrCreateAsset(ctx, tx, walletID, symbol)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	} else {
-		// Use the existing asset
-		assetEntity = assets[0]
+
+		// Increase the asset amount by the specified deposit value
+		assetEntity.Amount = assetEntity.Amount.Add(amount)
+
+		// Update the asset in the repository; fails with ErrConcurrentUpdate if the version moved
+		return s.assetRepository.UpdateAsset(ctx, tx, assetEntity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assetEntity, nil
+}
+
+// debit decreases the balance of an asset of a wallet, either from its available balance or from funds held for the
+// originating operation
+func (s *service) debit(ctx context.Context, tx *gorm.DB, walletID uint, symbol string, amount decimal.Decimal,
+	fromHold bool) (*entity.Asset, error) {
+	var assetEntity *entity.Asset
+
+	// Read, check, modify and write the balance, starting over whenever another writer got there first
+	err := retryOnConflict(func() error {
+		// Create the asset with a zero balance if it does not exist yet
+		var err error
+		assetEntity, err = s.getOrCreateAsset(ctx, tx, walletID, symbol)
+		if err != nil {
+			return err
+		}
+
+		if fromHold {
+			// Convert the hold placed for the operation into the debit
+			if assetEntity.Held.LessThan(amount) {
+				return ErrInsufficientHold
+			}
+			assetEntity.Held = assetEntity.Held.Sub(amount)
+		} else if assetEntity.Available().LessThan(amount) {
+			// Funds held for scheduled transfers cannot be withdrawn
+			return insufficientBalance(assetEntity, amount)
+		}
+
+		// Deduct the specified amount from the asset's balance
+		assetEntity.Amount = assetEntity.Amount.Sub(amount)
+
+		// Update the asset in the repository; fails with ErrConcurrentUpdate if the version moved
+		return s.assetRepository.UpdateAsset(ctx, tx, assetEntity)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate if the wallet has sufficient balance for the withdrawal
-	if assetEntity.Amount < request.Amount {
-		return nil, errors.New("amount is not enough to withdraw")
+	return assetEntity, nil
+}
+
+// getOrCreateAsset fetches the asset of a wallet, creating it with a zero balance and writing an AssetCreated event
+// when it does not exist yet
+func (s *service) getOrCreateAsset(ctx context.Context, tx *gorm.DB, walletID uint, name string) (*entity.Asset,
+	error) {
+	// Fetch existing assets for the specified wallet and asset name
+	assets, err := s.assetRepository.GetAsset(ctx, tx, entity.Filters{
+		Name:     []string{name},
+		WalletID: []uint{walletID},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Deduct the specified amount from the asset's balance
-	assetEntity.Amount -= request.Amount
+	// Use the existing asset if there is one
+	if len(assets) > 0 {
+		return assets[0], nil
+	}
 
-	// Update the asset in the repository
-	err = s.assetRepository.UpdateAsset(ctx, tx, assetEntity)
+	// Insert without raising a unique violation, which would abort the transaction the balance change runs in
+	assetEntity := &entity.Asset{WalletID: walletID, Name: name}
+	created, err := s.assetRepository.CreateAssetIfMissing(ctx, tx, assetEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		// A concurrent writer created the asset after it was looked up, so its row is read instead
+		assets, err = s.assetRepository.GetAsset(ctx, tx, entity.Filters{
+			Name:     []string{name},
+			WalletID: []uint{walletID},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(assets) == 0 {
+			return nil, ErrConcurrentUpdate
+		}
+
+		return assets[0], nil
+	}
+
+	err = s.recordEvent(ctx, tx, outboxentity.AssetCreated, assetEntity, decimal.Zero, ledgerentity.Reference{})
 	if err != nil {
 		return nil, err
 	}
 
-	// Return the updated asset
 	return assetEntity, nil
 }
+
+// recordEvent writes an event about an asset and the change of its balance by amount to the outbox as part of tx
+func (s *service) recordEvent(ctx context.Context, tx *gorm.DB, eventType outboxentity.EventType,
+	assetEntity *entity.Asset, amount decimal.Decimal, reference ledgerentity.Reference) error {
+	return outbox.Record(ctx, s.outboxRepository, tx, eventType, assetEntity.ID, outboxentity.AssetPayload{
+		AssetID:       assetEntity.ID,
+		WalletID:      assetEntity.WalletID,
+		Asset:         assetEntity.Name,
+		Amount:        amount,
+		Balance:       assetEntity.Amount,
+		Held:          assetEntity.Held,
+		ReferenceType: string(reference.Type),
+		ReferenceID:   reference.ID,
+	})
+}
+
+// inTransaction runs fn inside the caller's transaction when there is one, or inside a new transaction otherwise
+func (s *service) inTransaction(ctx context.Context, tx *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if tx != nil {
+		return fn(tx)
+	}
+
+	return s.assetRepository.InTransaction(ctx, fn)
+}
+
+// insufficientBalance reports that the available balance of an asset does not cover the requested amount, telling
+// the client how much is available
+func insufficientBalance(assetEntity *entity.Asset, requested decimal.Decimal) error {
+	return ErrInsufficientBalance.WithDetails(apperror.Details{
+		"wallet_id": assetEntity.WalletID,
+		"asset":     assetEntity.Name,
+		"available": assetEntity.Available(),
+		"requested": requested,
+	})
+}
+
+// retryOnConflict runs fn again when it loses an optimistic locking race on the balance version, up to
+// maxUpdateAttempts times. Only conflicts that leave the transaction usable are retried; a failed statement aborts a
+// Postgres transaction, so fn must never rely on one failing.
+func retryOnConflict(fn func() error) error {
+	var err error
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		err = fn()
+		if !errors.Is(err, ErrConcurrentUpdate) {
+			return err
+		}
+	}
+
+	return err
+}
@@ -6,39 +6,55 @@ import (
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
 	assetmock "github.com/safayildirim/asset-management-service/internal/asset/mock"
 	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	catalogentity "github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/asset-management-service/internal/ledger/mock"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	outboxmock "github.com/safayildirim/asset-management-service/internal/outbox/mock"
+	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
 	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
 	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 func TestService_CreateAsset(t *testing.T) {
 	tests := []struct {
-		name           string
-		request        *request.CreateAssetRequest
-		mockRepo       bool
-		mockReturn     *entity.Asset
-		mockError      error
-		expectedResult *entity.Asset
-		expectedError  error
+		name              string
+		request           *request.CreateAssetRequest
+		mockDefinition    *catalogentity.AssetDefinition
+		mockDefinitionErr error
+		mockRepo          bool
+		mockReturn        *entity.Asset
+		mockError         error
+		expectedResult    *entity.Asset
+		expectedError     error
 	}{
 		{
 			name: "when request is valid then should create asset",
 			request: &request.CreateAssetRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
-			mockRepo: true,
+			mockDefinition: &catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true},
+			mockRepo:       true,
 			mockReturn: &entity.Asset{
 				ID:       1,
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
 			mockError:      nil,
-			expectedResult: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: 10.0},
+			expectedResult: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
 			expectedError:  nil,
 		},
 		{
@@ -47,24 +63,66 @@ func TestService_CreateAsset(t *testing.T) {
 			request: &request.CreateAssetRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
+			mockDefinition: &catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true},
 			mockReturn:     nil,
 			mockError:      errors.New("repository error"),
 			expectedResult: nil,
 			expectedError:  errors.New("repository error"),
 		},
+		{
+			name: "when lower case symbol is provided then should create asset with canonical symbol",
+			request: &request.CreateAssetRequest{
+				WalletID: 1,
+				Name:     "btc",
+				Amount:   decimal.Zero,
+			},
+			mockDefinition: &catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true},
+			mockRepo:       true,
+			mockReturn:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.Zero},
+			expectedResult: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.Zero},
+		},
+		{
+			name: "when asset is unknown then should return error",
+			request: &request.CreateAssetRequest{
+				WalletID: 1,
+				Name:     "Bitcoin",
+				Amount:   decimal.NewFromInt(10),
+			},
+			mockDefinitionErr: catalog.ErrUnknownAsset,
+			expectedResult:    nil,
+			expectedError:     catalog.ErrUnknownAsset,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockLedgerRepository := ledgermock.NewMockLedgerRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockRepository, mockWalletClient)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockRepository, mockLedgerRepository, mockOutboxRepository, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.Name, tt.request.Amount).
+				Return(tt.mockDefinition, tt.mockDefinitionErr).Once()
 			if tt.mockRepo {
-				mockRepository.EXPECT().CreateAsset(mock.Anything, mock.Anything, mock.Anything).
+				mockRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockRepository.EXPECT().CreateAsset(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(item *entity.Asset) bool { return item.Name == tt.mockDefinition.Symbol })).
 					Return(tt.mockReturn, tt.mockError).Once()
 			}
+			if tt.mockRepo && tt.mockError == nil {
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.AssetCreated &&
+							events[0].AggregateID == tt.mockReturn.ID
+					})).Return(nil).Once()
+			}
 
 			// Call the service method
 			result, err := s.CreateAsset(context.Background(), nil, tt.request)
@@ -82,6 +140,11 @@ func TestService_CreateAsset(t *testing.T) {
 }
 
 func TestService_GetAssets(t *testing.T) {
+	amount := func(value int64) *decimal.Decimal {
+		d := decimal.NewFromInt(value)
+		return &d
+	}
+
 	tests := []struct {
 		name           string
 		request        *request.GetAssetsParams
@@ -89,7 +152,7 @@ func TestService_GetAssets(t *testing.T) {
 		mockRepo       bool
 		mockReturn     []*entity.Asset
 		mockError      error
-		expectedResult []*entity.Asset
+		expectedResult *common.Page[*entity.Asset]
 		expectedError  error
 	}{
 		{
@@ -104,16 +167,17 @@ func TestService_GetAssets(t *testing.T) {
 				ID:       []uint{1, 2},
 				Name:     []string{"BTC", "ETH"},
 				WalletID: []uint{1001},
+				Page:     &common.PageQuery{Limit: common.DefaultPageLimit, Sort: common.Sort{Field: "id"}},
 			},
 			mockReturn: []*entity.Asset{
-				{ID: 1, WalletID: 1001, Name: "BTC", Amount: 10.0},
-				{ID: 2, WalletID: 1001, Name: "ETH", Amount: 5.0},
+				{ID: 1, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)},
+				{ID: 2, WalletID: 1001, Name: "ETH", Amount: decimal.NewFromInt(5)},
 			},
 			mockError: nil,
-			expectedResult: []*entity.Asset{
-				{ID: 1, WalletID: 1001, Name: "BTC", Amount: 10.0},
-				{ID: 2, WalletID: 1001, Name: "ETH", Amount: 5.0},
-			},
+			expectedResult: &common.Page[*entity.Asset]{Items: []*entity.Asset{
+				{ID: 1, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)},
+				{ID: 2, WalletID: 1001, Name: "ETH", Amount: decimal.NewFromInt(5)},
+			}, Limit: common.DefaultPageLimit},
 			expectedError: nil,
 		},
 		{
@@ -128,10 +192,11 @@ func TestService_GetAssets(t *testing.T) {
 				ID:       []uint{3},
 				Name:     []string{"LTC"},
 				WalletID: []uint{2001},
+				Page:     &common.PageQuery{Limit: common.DefaultPageLimit, Sort: common.Sort{Field: "id"}},
 			},
 			mockReturn:     []*entity.Asset{},
 			mockError:      nil,
-			expectedResult: []*entity.Asset{},
+			expectedResult: &common.Page[*entity.Asset]{Items: []*entity.Asset{}, Limit: common.DefaultPageLimit},
 			expectedError:  nil,
 		},
 		{
@@ -146,21 +211,56 @@ func TestService_GetAssets(t *testing.T) {
 				ID:       []uint{4},
 				Name:     []string{"DOGE"},
 				WalletID: []uint{3001},
+				Page:     &common.PageQuery{Limit: common.DefaultPageLimit, Sort: common.Sort{Field: "id"}},
 			},
 			mockReturn:     nil,
 			mockError:      errors.New("repository error"),
 			expectedResult: nil,
 			expectedError:  errors.New("repository error"),
 		},
+		{
+			name:     "when more assets match than the limit then should return the cursor of the next page",
+			mockRepo: true,
+			request: &request.GetAssetsParams{
+				MinAmount: amount(1),
+				Limit:     2,
+				Sort:      "-amount",
+				Cursor:    common.Cursor{Sort: "-amount", Value: "20", ID: 9}.Encode(),
+			},
+			mockFilters: entity.Filters{
+				Name:      []string{},
+				MinAmount: amount(1),
+				Page: &common.PageQuery{Limit: 2, Sort: common.Sort{Field: "amount", Desc: true},
+					After: &common.Cursor{Sort: "-amount", Value: "20", ID: 9}},
+			},
+			mockReturn: []*entity.Asset{
+				{ID: 3, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)},
+				{ID: 1, WalletID: 1002, Name: "BTC", Amount: decimal.NewFromInt(5)},
+				{ID: 2, WalletID: 1003, Name: "BTC", Amount: decimal.NewFromInt(5)},
+			},
+			expectedResult: &common.Page[*entity.Asset]{Items: []*entity.Asset{
+				{ID: 3, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)},
+				{ID: 1, WalletID: 1002, Name: "BTC", Amount: decimal.NewFromInt(5)},
+			}, Limit: 2, NextCursor: common.Cursor{Sort: "-amount", Value: "5", ID: 1}.Encode()},
+		},
+		{
+			name:          "when cursor is malformed then should return invalid cursor error",
+			request:       &request.GetAssetsParams{Cursor: "not-a-cursor"},
+			expectedError: common.ErrInvalidCursor,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockLedgerRepository := ledgermock.NewMockLedgerRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockRepository, mockWalletClient)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockRepository, mockLedgerRepository, mockOutboxRepository, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 			if tt.mockRepo {
-				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything).
+				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything, tt.mockFilters).
 					Return(tt.mockReturn, tt.mockError).Once()
 			}
 
@@ -179,10 +279,60 @@ func TestService_GetAssets(t *testing.T) {
 	}
 }
 
+func TestService_GetAsset(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockReturn     []*entity.Asset
+		mockError      error
+		expectedResult *entity.Asset
+		expectedError  error
+	}{
+		{
+			name:           "when asset exists then should return it",
+			mockReturn:     []*entity.Asset{{ID: 1, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)}},
+			expectedResult: &entity.Asset{ID: 1, WalletID: 1001, Name: "BTC", Amount: decimal.NewFromInt(10)},
+		},
+		{
+			name:          "when asset does not exist then should return not found error",
+			mockReturn:    []*entity.Asset{},
+			expectedError: ErrAssetNotFound,
+		},
+		{
+			name:          "when repository error then should return error",
+			mockError:     errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := assetmock.NewMockAssetRepository(t)
+			s := NewService(mockRepository, ledgermock.NewMockLedgerRepository(t),
+				outboxmock.NewMockOutboxRepository(t), catalogmock.NewMockCatalogService(t),
+				walletmock.NewMockWalletClient(t), walletpkg.NewRules(nil))
+
+			mockRepository.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{ID: []uint{1}}).
+				Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.GetAsset(context.Background(), 1)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
 func TestService_Deposit(t *testing.T) {
 	tests := []struct {
 		name               string
 		request            *request.CreateDepositRequest
+		expectedDirection  ledgerentity.Direction
+		mockDefinitionErr  error
 		mockWallet         *walletentity.Wallet
 		mockWalletErr      error
 		mockAsset          bool
@@ -190,28 +340,49 @@ func TestService_Deposit(t *testing.T) {
 		mockAssetsErr      error
 		mockCreate         *entity.Asset
 		mockCreateErr      error
-		mockUpdate         bool
-		mockUpdateErr      error
-		expectedResult     *entity.Asset
-		expectedError      error
+		// mockCreatedConcurrently is the row of a concurrent writer that created the asset first
+		mockCreatedConcurrently []*entity.Asset
+		mockUpdate              bool
+		mockUpdateErr           error
+		expectedResult          *entity.Asset
+		expectedError           error
 	}{
 		{
 			name: "when request is valid then should deposit amount",
 			request: &request.CreateDepositRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
 			mockWallet:         &walletentity.Wallet{ID: 1},
 			mockWalletErr:      nil,
 			mockAsset:          true,
-			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: 5.0}},
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(5)}},
 			mockAssetsErr:      nil,
 			mockCreate:         nil,
 			mockCreateErr:      nil,
 			mockUpdate:         true,
+			expectedDirection:  ledgerentity.Credit,
 			mockUpdateErr:      nil,
-			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: 15.0},
+			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(15)},
+			expectedError:      nil,
+		},
+		{
+			name: "when fractional amounts are deposited then should add them exactly",
+			request: &request.CreateDepositRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.RequireFromString("0.2"),
+			},
+			mockWallet:         &walletentity.Wallet{ID: 1},
+			mockWalletErr:      nil,
+			mockAsset:          true,
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("0.1")}},
+			mockAssetsErr:      nil,
+			mockUpdate:         true,
+			expectedDirection:  ledgerentity.Credit,
+			mockUpdateErr:      nil,
+			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("0.3")},
 			expectedError:      nil,
 		},
 		{
@@ -219,26 +390,64 @@ func TestService_Deposit(t *testing.T) {
 			request: &request.CreateDepositRequest{
 				WalletID: 2,
 				Name:     "ETH",
-				Amount:   20.0,
+				Amount:   decimal.NewFromInt(20),
 			},
 			mockWallet:         &walletentity.Wallet{ID: 2},
 			mockWalletErr:      nil,
 			mockAsset:          true,
 			mockAssetsResponse: []*entity.Asset{},
 			mockAssetsErr:      nil,
-			mockCreate:         &entity.Asset{ID: 2, WalletID: 2, Name: "ETH", Amount: 0},
+			mockCreate:         &entity.Asset{ID: 2, WalletID: 2, Name: "ETH", Amount: decimal.NewFromInt(0)},
 			mockCreateErr:      nil,
 			mockUpdate:         true,
+			expectedDirection:  ledgerentity.Credit,
 			mockUpdateErr:      nil,
-			expectedResult:     &entity.Asset{ID: 2, WalletID: 2, Name: "ETH", Amount: 20.0},
+			expectedResult:     &entity.Asset{ID: 2, WalletID: 2, Name: "ETH", Amount: decimal.NewFromInt(20)},
 			expectedError:      nil,
 		},
+		{
+			name: "when another deposit created the asset concurrently then should deposit into its row",
+			request: &request.CreateDepositRequest{
+				WalletID: 2,
+				Name:     "ETH",
+				Amount:   decimal.NewFromInt(20),
+			},
+			mockWallet:              &walletentity.Wallet{ID: 2},
+			mockAsset:               true,
+			mockAssetsResponse:      []*entity.Asset{},
+			mockCreatedConcurrently: []*entity.Asset{{ID: 2, WalletID: 2, Name: "ETH", Amount: decimal.NewFromInt(5)}},
+			mockUpdate:              true,
+			expectedDirection:       ledgerentity.Credit,
+			expectedResult:          &entity.Asset{ID: 2, WalletID: 2, Name: "ETH", Amount: decimal.NewFromInt(25)},
+		},
+		{
+			name: "when asset is disabled then should return error",
+			request: &request.CreateDepositRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.NewFromInt(10),
+			},
+			mockDefinitionErr: catalog.ErrAssetDisabled,
+			expectedResult:    nil,
+			expectedError:     catalog.ErrAssetDisabled,
+		},
+		{
+			name: "when wallet is deleted then should return error",
+			request: &request.CreateDepositRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.NewFromInt(10),
+			},
+			mockWallet:     &walletentity.Wallet{ID: 1, DeletedAt: null.TimeFrom(time.Now())},
+			expectedResult: nil,
+			expectedError:  walletpkg.ErrWalletDeleted,
+		},
 		{
 			name: "when wallet not found then should return error",
 			request: &request.CreateDepositRequest{
 				WalletID: 3,
 				Name:     "LTC",
-				Amount:   15.0,
+				Amount:   decimal.NewFromInt(15),
 			},
 			mockWallet:     nil,
 			mockWalletErr:  errors.New("wallet not found"),
@@ -253,7 +462,7 @@ func TestService_Deposit(t *testing.T) {
 			request: &request.CreateDepositRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
 			mockWallet:     &walletentity.Wallet{ID: 1},
 			mockWalletErr:  nil,
@@ -270,19 +479,66 @@ func TestService_Deposit(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockLedgerRepository := ledgermock.NewMockLedgerRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockRepository, mockWalletClient)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockRepository, mockLedgerRepository, mockOutboxRepository, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 
-			mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.WalletID).
-				Return(tt.mockWallet, tt.mockWalletErr).Once()
+			definition := &catalogentity.AssetDefinition{Symbol: tt.request.Name, Decimals: 8, Enabled: true}
+			if tt.mockDefinitionErr != nil {
+				definition = nil
+			}
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.Name, tt.request.Amount).
+				Return(definition, tt.mockDefinitionErr).Once()
+
+			if tt.mockDefinitionErr == nil {
+				mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.WalletID).
+					Return(tt.mockWallet, tt.mockWalletErr).Once()
+			}
 
 			if tt.mockAsset {
-				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything).
+				mockRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything, mock.Anything).
 					Return(tt.mockAssetsResponse, tt.mockAssetsErr).Once()
 			}
+			if tt.mockUpdate && tt.mockUpdateErr == nil {
+				mockLedgerRepository.EXPECT().CreateEntries(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(entries []*ledgerentity.Entry) bool {
+						return len(entries) == 2 && entries[0].Direction == tt.expectedDirection &&
+							entries[0].Amount.Equal(tt.request.Amount) &&
+							entries[0].BalanceAfter.Decimal.Equal(tt.expectedResult.Amount)
+					})).Return(nil).Once()
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.Deposited
+					})).Return(nil).Once()
+			}
 			if tt.mockCreate != nil || tt.mockCreateErr != nil {
-				mockRepository.EXPECT().CreateAsset(mock.Anything, mock.Anything, mock.Anything).
-					Return(tt.mockCreate, tt.mockCreateErr).Once()
+				mockRepository.EXPECT().CreateAssetIfMissing(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB, item *entity.Asset) (bool, error) {
+						if tt.mockCreateErr != nil {
+							return false, tt.mockCreateErr
+						}
+						*item = *tt.mockCreate
+						return true, nil
+					}).Once()
+			}
+			if tt.mockCreate != nil {
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.AssetCreated
+					})).Return(nil).Once()
+			}
+			if tt.mockCreatedConcurrently != nil {
+				mockRepository.EXPECT().CreateAssetIfMissing(mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil).Once()
+				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything, mock.Anything).
+					Return(tt.mockCreatedConcurrently, nil).Once()
 			}
 			if tt.mockUpdate {
 				mockRepository.EXPECT().UpdateAsset(mock.Anything, mock.Anything, mock.Anything).
@@ -308,6 +564,8 @@ func TestService_Withdraw(t *testing.T) {
 	tests := []struct {
 		name               string
 		request            *request.CreateWithdrawRequest
+		expectedDirection  ledgerentity.Direction
+		mockDefinitionErr  error
 		mockWallet         *walletentity.Wallet
 		mockWalletErr      error
 		mockAsset          bool
@@ -325,40 +583,111 @@ func TestService_Withdraw(t *testing.T) {
 			request: &request.CreateWithdrawRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   5.0,
+				Amount:   decimal.NewFromInt(5),
 			},
 			mockWallet:         &walletentity.Wallet{ID: 1},
 			mockWalletErr:      nil,
 			mockAsset:          true,
-			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: 10.0}},
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)}},
 			mockAssetsErr:      nil,
 			mockUpdate:         true,
+			expectedDirection:  ledgerentity.Debit,
 			mockUpdateErr:      nil,
-			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: 5.0},
+			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(5)},
 			expectedError:      nil,
 		},
+		{
+			name: "when sub-cent amount is withdrawn then should deduct it exactly",
+			request: &request.CreateWithdrawRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.RequireFromString("0.00000001"),
+			},
+			mockWallet:         &walletentity.Wallet{ID: 1},
+			mockWalletErr:      nil,
+			mockAsset:          true,
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("0.00000003")}},
+			mockAssetsErr:      nil,
+			mockUpdate:         true,
+			expectedDirection:  ledgerentity.Debit,
+			mockUpdateErr:      nil,
+			expectedResult:     &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("0.00000002")},
+			expectedError:      nil,
+		},
+		{
+			name: "when balance is short by the smallest unit then should return error",
+			request: &request.CreateWithdrawRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.RequireFromString("0.30000001"),
+			},
+			mockWallet:         &walletentity.Wallet{ID: 1},
+			mockWalletErr:      nil,
+			mockAsset:          true,
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("0.3")}},
+			mockAssetsErr:      nil,
+			expectedResult:     nil,
+			expectedError:      ErrInsufficientBalance,
+		},
 		{
 			name: "when balance is not enough then should return error",
 			request: &request.CreateWithdrawRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   15.0,
+				Amount:   decimal.NewFromInt(15),
 			},
 			mockWallet:         &walletentity.Wallet{ID: 1},
 			mockWalletErr:      nil,
 			mockAsset:          true,
-			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: 10.0}},
+			mockAssetsResponse: []*entity.Asset{{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)}},
 			mockAssetsErr:      nil,
 			mockUpdateErr:      nil,
 			expectedResult:     nil,
-			expectedError:      errors.New("amount is not enough to withdraw"),
+			expectedError:      ErrInsufficientBalance,
+		},
+		{
+			name: "when funds are held for scheduled transfers then should not withdraw them",
+			request: &request.CreateWithdrawRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.NewFromInt(5),
+			},
+			mockWallet: &walletentity.Wallet{ID: 1},
+			mockAsset:  true,
+			mockAssetsResponse: []*entity.Asset{
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10), Held: decimal.NewFromInt(6)},
+			},
+			expectedResult: nil,
+			expectedError:  ErrInsufficientBalance,
+		},
+		{
+			name: "when amount is more precise than the asset allows then should return error",
+			request: &request.CreateWithdrawRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.RequireFromString("0.000000001"),
+			},
+			mockDefinitionErr: catalog.ErrAmountPrecision,
+			expectedResult:    nil,
+			expectedError:     catalog.ErrAmountPrecision,
+		},
+		{
+			name: "when wallet is deleted then should return error",
+			request: &request.CreateWithdrawRequest{
+				WalletID: 1,
+				Name:     "BTC",
+				Amount:   decimal.NewFromInt(10),
+			},
+			mockWallet:     &walletentity.Wallet{ID: 1, DeletedAt: null.TimeFrom(time.Now())},
+			expectedResult: nil,
+			expectedError:  walletpkg.ErrWalletDeleted,
 		},
 		{
 			name: "when wallet not found then should return error",
 			request: &request.CreateWithdrawRequest{
 				WalletID: 2,
 				Name:     "ETH",
-				Amount:   10.0,
+				Amount:   decimal.NewFromInt(10),
 			},
 			mockWallet:         nil,
 			mockWalletErr:      errors.New("wallet not found"),
@@ -373,7 +702,7 @@ func TestService_Withdraw(t *testing.T) {
 			request: &request.CreateWithdrawRequest{
 				WalletID: 1,
 				Name:     "BTC",
-				Amount:   5.0,
+				Amount:   decimal.NewFromInt(5),
 			},
 			mockWallet:         &walletentity.Wallet{ID: 1},
 			mockWalletErr:      nil,
@@ -389,19 +718,60 @@ func TestService_Withdraw(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockLedgerRepository := ledgermock.NewMockLedgerRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockRepository, mockWalletClient)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockRepository, mockLedgerRepository, mockOutboxRepository, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 
-			mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.WalletID).
-				Return(tt.mockWallet, tt.mockWalletErr).Once()
+			definition := &catalogentity.AssetDefinition{Symbol: tt.request.Name, Decimals: 8, Enabled: true}
+			if tt.mockDefinitionErr != nil {
+				definition = nil
+			}
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.Name, tt.request.Amount).
+				Return(definition, tt.mockDefinitionErr).Once()
+
+			if tt.mockDefinitionErr == nil {
+				mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.WalletID).
+					Return(tt.mockWallet, tt.mockWalletErr).Once()
+			}
 
 			if tt.mockAsset {
-				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything).
+				mockRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockRepository.EXPECT().GetAsset(mock.Anything, mock.Anything, mock.Anything).
 					Return(tt.mockAssetsResponse, tt.mockAssetsErr).Once()
 			}
+			if tt.mockUpdate && tt.mockUpdateErr == nil {
+				mockLedgerRepository.EXPECT().CreateEntries(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(entries []*ledgerentity.Entry) bool {
+						return len(entries) == 2 && entries[0].Direction == tt.expectedDirection &&
+							entries[0].Amount.Equal(tt.request.Amount) &&
+							entries[0].BalanceAfter.Decimal.Equal(tt.expectedResult.Amount)
+					})).Return(nil).Once()
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.Withdrawn
+					})).Return(nil).Once()
+			}
 			if tt.mockCreate != nil || tt.mockCreateErr != nil {
-				mockRepository.EXPECT().CreateAsset(mock.Anything, mock.Anything, mock.Anything).
-					Return(tt.mockCreate, tt.mockCreateErr).Once()
+				mockRepository.EXPECT().CreateAssetIfMissing(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB, item *entity.Asset) (bool, error) {
+						if tt.mockCreateErr != nil {
+							return false, tt.mockCreateErr
+						}
+						*item = *tt.mockCreate
+						return true, nil
+					}).Once()
+			}
+			if tt.mockCreate != nil {
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, mock.Anything,
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.AssetCreated
+					})).Return(nil).Once()
 			}
 			if tt.mockUpdate {
 				mockRepository.EXPECT().UpdateAsset(mock.Anything, mock.Anything, mock.Anything).
@@ -422,3 +792,248 @@ func TestService_Withdraw(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Transfer(t *testing.T) {
+	reference := ledgerentity.Reference{Type: ledgerentity.ReferenceTransfer, ID: "7"}
+
+	tests := []struct {
+		name                  string
+		request               *request.TransferRequest
+		mockDestinationWallet *walletentity.Wallet
+		resolvedWallets       bool
+		mockSource            *entity.Asset
+		mockDestination       *entity.Asset
+		expectedSource        *entity.Asset
+		expectedDestination   *entity.Asset
+		expectedError         error
+	}{
+		{
+			name: "when balance is enough then should book both sides in one journal",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(4),
+				Reference: reference,
+			},
+			mockSource:          &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
+			mockDestination:     &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(1)},
+			expectedSource:      &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(6)},
+			expectedDestination: &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(5)},
+		},
+		{
+			name: "when the caller already resolved the wallets then should not look them up again",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(4),
+				Reference: reference, SourceWallet: &walletentity.Wallet{ID: 1},
+				DestinationWallet: &walletentity.Wallet{ID: 2},
+			},
+			resolvedWallets:     true,
+			mockSource:          &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
+			mockDestination:     &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(1)},
+			expectedSource:      &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(6)},
+			expectedDestination: &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(5)},
+		},
+		{
+			name: "when transferring from a hold then should convert the hold into the debit",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(6),
+				Reference: reference, FromHold: true,
+			},
+			mockSource: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10),
+				Held: decimal.NewFromInt(6)},
+			mockDestination: &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(1)},
+			expectedSource: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(4),
+				Held: decimal.NewFromInt(6).Sub(decimal.NewFromInt(6))},
+			expectedDestination: &entity.Asset{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(7)},
+		},
+		{
+			name: "when transferring more than is held then should return error",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(7),
+				Reference: reference, FromHold: true,
+			},
+			mockSource: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10),
+				Held: decimal.NewFromInt(6)},
+			expectedError: ErrInsufficientHold,
+		},
+		{
+			name: "when funds are held for scheduled transfers then should not transfer them",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(5),
+				Reference: reference,
+			},
+			mockSource: &entity.Asset{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10),
+				Held: decimal.NewFromInt(6)},
+			expectedError: ErrInsufficientBalance,
+		},
+		{
+			name: "when destination wallet is deleted then should return error",
+			request: &request.TransferRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, Name: "btc", Amount: decimal.NewFromInt(4),
+				Reference: reference,
+			},
+			mockDestinationWallet: &walletentity.Wallet{ID: 2, DeletedAt: null.TimeFrom(time.Now())},
+			expectedError:         walletpkg.ErrWalletDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockLedgerRepository := ledgermock.NewMockLedgerRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			mockWalletClient := walletmock.NewMockWalletClient(t)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockRepository, mockLedgerRepository, mockOutboxRepository, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
+
+			destinationWallet := tt.mockDestinationWallet
+			if destinationWallet == nil {
+				destinationWallet = &walletentity.Wallet{ID: 2}
+			}
+
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "btc", tt.request.Amount).
+				Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true}, nil).Once()
+			if !tt.resolvedWallets {
+				mockWalletClient.EXPECT().GetWallet(mock.Anything, uint(1)).Return(&walletentity.Wallet{ID: 1}, nil).
+					Once()
+				mockWalletClient.EXPECT().GetWallet(mock.Anything, uint(2)).Return(destinationWallet, nil).Once()
+			}
+
+			if tt.mockSource != nil {
+				mockRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockRepository.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+					Name: []string{"BTC"}, WalletID: []uint{1},
+				}).Return([]*entity.Asset{tt.mockSource}, nil).Once()
+			}
+			if tt.mockDestination != nil {
+				mockRepository.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+					Name: []string{"BTC"}, WalletID: []uint{2},
+				}).Return([]*entity.Asset{tt.mockDestination}, nil).Once()
+				mockRepository.EXPECT().UpdateAsset(mock.Anything, (*gorm.DB)(nil), mock.Anything).Return(nil).
+					Twice()
+				mockLedgerRepository.EXPECT().CreateEntries(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(entries []*ledgerentity.Entry) bool {
+						return len(entries) == 2 &&
+							entries[0].WalletID.Int64 == 1 && entries[0].Direction == ledgerentity.Debit &&
+							entries[0].BalanceAfter.Decimal.Equal(tt.expectedSource.Amount) &&
+							entries[1].WalletID.Int64 == 2 && entries[1].Direction == ledgerentity.Credit &&
+							entries[1].BalanceAfter.Decimal.Equal(tt.expectedDestination.Amount) &&
+							entries[0].JournalID == entries[1].JournalID &&
+							entries[0].Amount.Equal(tt.request.Amount) && entries[1].Amount.Equal(tt.request.Amount) &&
+							entries[0].ReferenceID.String == "7" && entries[1].ReferenceType == reference.Type
+					})).Return(nil).Once()
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.Withdrawn
+					})).Return(nil).Once()
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.Deposited
+					})).Return(nil).Once()
+			}
+
+			err := s.Transfer(context.Background(), nil, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSource, tt.mockSource)
+			assert.Equal(t, tt.expectedDestination, tt.mockDestination)
+		})
+	}
+}
+
+func TestService_Hold(t *testing.T) {
+	tests := []struct {
+		name               string
+		release            bool
+		amount             decimal.Decimal
+		mockAssetsResponse []*entity.Asset
+		mockUpdate         bool
+		expectedHeld       decimal.Decimal
+		expectedError      error
+	}{
+		{
+			name:               "when available balance is enough then should hold the amount",
+			amount:             decimal.NewFromInt(4),
+			mockAssetsResponse: []*entity.Asset{{ID: 1, Amount: decimal.NewFromInt(10), Held: decimal.NewFromInt(5)}},
+			mockUpdate:         true,
+			expectedHeld:       decimal.NewFromInt(9),
+		},
+		{
+			name:               "when available balance is not enough then should return error",
+			amount:             decimal.NewFromInt(6),
+			mockAssetsResponse: []*entity.Asset{{ID: 1, Amount: decimal.NewFromInt(10), Held: decimal.NewFromInt(5)}},
+			expectedError:      ErrInsufficientBalance,
+		},
+		{
+			name:          "when wallet has no such asset then should return error",
+			amount:        decimal.NewFromInt(1),
+			expectedError: ErrInsufficientBalance,
+		},
+		{
+			name:               "when hold is released then should return the amount to the available balance",
+			release:            true,
+			amount:             decimal.NewFromInt(5),
+			mockAssetsResponse: []*entity.Asset{{ID: 1, Amount: decimal.NewFromInt(10), Held: decimal.NewFromInt(5)}},
+			mockUpdate:         true,
+			expectedHeld:       decimal.NewFromInt(0),
+		},
+		{
+			name:               "when more than is held is released then should return error",
+			release:            true,
+			amount:             decimal.NewFromInt(6),
+			mockAssetsResponse: []*entity.Asset{{ID: 1, Amount: decimal.NewFromInt(10), Held: decimal.NewFromInt(5)}},
+			expectedError:      ErrInsufficientHold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			s := NewService(mockRepository, ledgermock.NewMockLedgerRepository(t),
+				outboxmock.NewMockOutboxRepository(t), mockCatalogService,
+				walletmock.NewMockWalletClient(t), walletpkg.NewRules(nil))
+
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "btc", tt.amount).
+				Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true}, nil).Once()
+			mockRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockRepository.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+				Name: []string{"BTC"}, WalletID: []uint{1},
+			}).Return(tt.mockAssetsResponse, nil).Once()
+
+			if tt.mockUpdate {
+				mockRepository.EXPECT().UpdateAsset(mock.Anything, (*gorm.DB)(nil), mock.Anything).Return(nil).Once()
+			}
+
+			holdRequest := &request.HoldRequest{WalletID: 1, Name: "btc", Amount: tt.amount}
+
+			var (
+				result *entity.Asset
+				err    error
+			)
+			if tt.release {
+				result, err = s.ReleaseHold(context.Background(), nil, holdRequest)
+			} else {
+				result, err = s.Hold(context.Background(), nil, holdRequest)
+			}
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tt.expectedHeld.Equal(result.Held), "expected held %s, got %s", tt.expectedHeld,
+					result.Held)
+				assert.True(t, decimal.NewFromInt(10).Equal(result.Amount))
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+)
+import (
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+)
+
+type AssetDefinition struct {
+	ID              uint            `json:"id"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       null.Time       `json:"updated_at"`
+	Symbol          string          `json:"symbol"`
+	DisplayName     string          `json:"display_name"`
+	Decimals        int32           `json:"decimals"`
+	MinTransferUnit decimal.Decimal `json:"min_transfer_unit"`
+	Enabled         bool            `json:"enabled"`
+}
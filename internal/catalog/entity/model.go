@@ -0,0 +1,9 @@
+package entity
+
+import "gopkg.in/guregu/null.v3"
+
+type Filters struct {
+	ID      []uint
+	Symbol  []string
+	Enabled null.Bool
+}
@@ -0,0 +1,23 @@
+package catalog
+
+import (
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrDefinitionNotFound = apperror.New(apperror.CodeAssetDefinitionNotFound, http.StatusNotFound,
+		"asset definition not found")
+	ErrDuplicateDefinition = apperror.New(apperror.CodeAssetDefinitionExists, http.StatusConflict,
+		"asset definition already exist")
+	ErrUnknownAsset    = apperror.New(apperror.CodeUnknownAsset, http.StatusBadRequest, "unknown asset")
+	ErrAssetDisabled   = apperror.New(apperror.CodeAssetDisabled, http.StatusBadRequest, "asset is disabled")
+	ErrAmountPrecision = apperror.New(apperror.CodeAmountPrecision, http.StatusBadRequest,
+		"amount has more decimal places than the asset allows")
+	ErrAmountBelowMinimum = apperror.New(apperror.CodeAmountBelowMinimum, http.StatusBadRequest,
+		"amount is below the minimum transferable unit")
+	ErrDefinitionInUse = apperror.New(apperror.CodeAssetDefinitionInUse, http.StatusConflict,
+		"asset is still held by wallets or moved by pending transactions, disable it instead")
+	ErrDecimalsBelowStoredScale = apperror.New(apperror.CodeDecimalsBelowStoredScale, http.StatusConflict,
+		"decimals are lower than the precision of amounts already stored")
+)
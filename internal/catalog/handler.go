@@ -0,0 +1,113 @@
+package catalog
+
+import (
+	"github.com/gorilla/schema"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/catalog/request"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+var decoder = schema.NewDecoder()
+
+func init() {
+	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
+		return reflect.ValueOf(strings.Split(value, ","))
+	})
+}
+
+type Handler struct {
+	catalogService Service
+}
+
+// NewHandler initializes a new Handler instance with the provided catalog service
+func NewHandler(catalogService Service) *Handler {
+	return &Handler{catalogService: catalogService}
+}
+
+// RegisterRoutes registers the asset definition API routes with the provided Echo router group
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/asset-definitions", h.CreateDefinition)
+	e.GET("/asset-definitions", h.GetDefinitions)
+	e.GET("/asset-definitions/:symbol", h.GetDefinition)
+	e.PATCH("/asset-definitions/:symbol", h.UpdateDefinition)
+	e.DELETE("/asset-definitions/:symbol", h.DeleteDefinition)
+}
+
+// CreateDefinition handles requests to add an asset to the catalogue
+func (h Handler) CreateDefinition(ctx echo.Context) error {
+	var req request.CreateDefinitionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	definition, err := h.catalogService.CreateDefinition(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, common.Response{Data: definition})
+}
+
+// GetDefinitions handles requests to list asset definitions
+func (h Handler) GetDefinitions(ctx echo.Context) error {
+	var req request.GetDefinitionsParams
+	params := ctx.QueryParams()
+
+	err := decoder.Decode(&req, params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	definitions, err := h.catalogService.GetDefinitions(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: definitions})
+}
+
+// GetDefinition handles requests to fetch a single asset definition by symbol
+func (h Handler) GetDefinition(ctx echo.Context) error {
+	definition, err := h.catalogService.GetDefinition(ctx.Request().Context(), ctx.Param("symbol"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: definition})
+}
+
+// UpdateDefinition handles requests to change an asset definition
+func (h Handler) UpdateDefinition(ctx echo.Context) error {
+	var req request.UpdateDefinitionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	definition, err := h.catalogService.UpdateDefinition(ctx.Request().Context(), ctx.Param("symbol"), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: definition})
+}
+
+// DeleteDefinition handles requests to remove an asset definition from the catalogue
+func (h Handler) DeleteDefinition(ctx echo.Context) error {
+	err := h.catalogService.DeleteDefinition(ctx.Request().Context(), ctx.Param("symbol"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,284 @@
+package catalog
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_CreateDefinition(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.AssetDefinition
+		mockError            error
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when valid request body is provided then should create definition",
+			body:        `{"symbol":"BTC","display_name":"Bitcoin","decimals":8,"min_transfer_unit":"0.00000001"}`,
+			mockService: true,
+			mockReturn:  &entity.AssetDefinition{ID: 1, Symbol: "BTC", DisplayName: "Bitcoin", Decimals: 8},
+		},
+		{
+			name:                 "when symbol is missing then should return bad request",
+			body:                 `{"display_name":"Bitcoin","decimals":8}`,
+			expectErr:            true,
+			expectedErrorMessage: "symbol: cannot be blank",
+		},
+		{
+			name:                 "when decimals are out of range then should return bad request",
+			body:                 `{"symbol":"BTC","display_name":"Bitcoin","decimals":19}`,
+			expectErr:            true,
+			expectedErrorMessage: "decimals: must be no greater than 18",
+		},
+		{
+			name:                 "when minimum transfer unit does not fit decimals then should return bad request",
+			body:                 `{"symbol":"BTC","display_name":"Bitcoin","decimals":2,"min_transfer_unit":"0.001"}`,
+			expectErr:            true,
+			expectedErrorMessage: "min_transfer_unit: must not have more decimal places than decimals",
+		},
+		{
+			name:                 "when definition already exists then should return conflict",
+			body:                 `{"symbol":"BTC","display_name":"Bitcoin","decimals":8}`,
+			mockService:          true,
+			mockError:            ErrDuplicateDefinition,
+			expectErr:            true,
+			expectedErrorMessage: "asset definition already exist",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"symbol":"BTC","display_name":"Bitcoin","decimals":8}`,
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectErr:            true,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := catalogmock.NewMockCatalogService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateDefinition(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/asset-definitions", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateDefinition(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_GetDefinition(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name           string
+		symbol         string
+		mockReturn     *entity.AssetDefinition
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "when definition exists then should return it",
+			symbol:         "BTC",
+			mockReturn:     &entity.AssetDefinition{ID: 1, Symbol: "BTC"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "when definition does not exist then should return not found",
+			symbol:         "DOGE",
+			mockError:      ErrDefinitionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when service returns error then should return internal server error",
+			symbol:         "BTC",
+			mockError:      errors.New("internal server error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := catalogmock.NewMockCatalogService(t)
+			handler := NewHandler(mockService)
+
+			mockService.EXPECT().GetDefinition(mock.Anything, tt.symbol).Return(tt.mockReturn, tt.mockError).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/asset-definitions/"+tt.symbol, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/asset-definitions/:symbol")
+			ctx.SetParamNames("symbol")
+			ctx.SetParamValues(tt.symbol)
+
+			err := handler.GetDefinition(ctx)
+
+			if tt.mockError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateDefinition(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.AssetDefinition
+		mockError            error
+		expectErr            bool
+		expectedStatus       int
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when valid request body is provided then should update definition",
+			body:           `{"enabled":false}`,
+			mockService:    true,
+			mockReturn:     &entity.AssetDefinition{ID: 1, Symbol: "BTC", Enabled: false},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when display name is empty then should return bad request",
+			body:                 `{"display_name":""}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "display_name: cannot be blank",
+		},
+		{
+			name:                 "when definition does not exist then should return not found",
+			body:                 `{"enabled":true}`,
+			mockService:          true,
+			mockError:            ErrDefinitionNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusNotFound,
+			expectedErrorMessage: "asset definition not found",
+		},
+		{
+			name:                 "when new precision is invalid then should return bad request",
+			body:                 `{"decimals":2}`,
+			mockService:          true,
+			mockError:            ErrAmountPrecision,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "more decimal places",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := catalogmock.NewMockCatalogService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().UpdateDefinition(mock.Anything, "BTC", mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/asset-definitions/BTC", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/asset-definitions/:symbol")
+			ctx.SetParamNames("symbol")
+			ctx.SetParamValues("BTC")
+
+			err := handler.UpdateDefinition(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteDefinition(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "when definition exists then should delete it",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "when definition does not exist then should return not found",
+			mockError:      ErrDefinitionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when wallets still hold the asset then should return conflict",
+			mockError:      ErrDefinitionInUse,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := catalogmock.NewMockCatalogService(t)
+			handler := NewHandler(mockService)
+
+			mockService.EXPECT().DeleteDefinition(mock.Anything, "BTC").Return(tt.mockError).Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/asset-definitions/BTC", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/asset-definitions/:symbol")
+			ctx.SetParamNames("symbol")
+			ctx.SetParamValues("BTC")
+
+			err := handler.DeleteDefinition(ctx)
+
+			if tt.mockError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
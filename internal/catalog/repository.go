@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	"gorm.io/gorm"
+	"strings"
+)
+
+type Repository interface {
+	CreateDefinition(ctx context.Context, item *entity.AssetDefinition) (*entity.AssetDefinition, error)
+	GetDefinitions(ctx context.Context, filters entity.Filters) ([]*entity.AssetDefinition, error)
+	UpdateDefinition(ctx context.Context, item *entity.AssetDefinition) error
+	DeleteDefinition(ctx context.Context, id uint) error
+	IsInUse(ctx context.Context, symbol string) (bool, error)
+	GetStoredScale(ctx context.Context, symbol string) (int32, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateDefinition(ctx context.Context, item *entity.AssetDefinition) (*entity.AssetDefinition,
+	error) {
+	err := r.db.WithContext(ctx).Create(item).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrDuplicateDefinition
+		}
+
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repository) GetDefinitions(ctx context.Context, filters entity.Filters) ([]*entity.AssetDefinition, error) {
+	var definitions []*entity.AssetDefinition
+
+	query := r.db.WithContext(ctx).Model(&entity.AssetDefinition{})
+
+	if len(filters.ID) > 0 {
+		query = query.Where("id IN ?", filters.ID)
+	}
+	if len(filters.Symbol) > 0 {
+		query = query.Where("symbol IN ?", filters.Symbol)
+	}
+	if filters.Enabled.Valid {
+		query = query.Where("enabled = ?", filters.Enabled.Bool)
+	}
+
+	err := query.Order("symbol").Find(&definitions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+func (r *repository) UpdateDefinition(ctx context.Context, item *entity.AssetDefinition) error {
+	err := r.db.WithContext(ctx).Save(item).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteDefinition(ctx context.Context, id uint) error {
+	err := r.db.WithContext(ctx).Delete(&entity.AssetDefinition{}, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsInUse reports whether any wallet holds a non-zero balance of the asset, or a pending transaction or a live
+// recurring schedule is about to move it
+func (r *repository) IsInUse(ctx context.Context, symbol string) (bool, error) {
+	var inUse bool
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT EXISTS (SELECT 1 FROM assets WHERE name = @symbol AND (amount > 0 OR held > 0))
+		           OR EXISTS (SELECT 1 FROM scheduled_transactions WHERE asset_name = @symbol AND status = 'pending')
+		           OR EXISTS (SELECT 1 FROM recurring_schedules
+		                      WHERE asset_name = @symbol AND status IN ('active', 'paused'))`,
+		sql.Named("symbol", symbol)).Scan(&inUse).Error
+	if err != nil {
+		return false, err
+	}
+
+	return inUse, nil
+}
+
+// GetStoredScale returns the largest number of fractional digits, ignoring trailing zeros, among the amounts of the
+// asset that are still in use: balances, holds, pending transactions and live recurring schedules
+func (r *repository) GetStoredScale(ctx context.Context, symbol string) (int32, error) {
+	var scale int32
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT coalesce(max(scale), 0)
+		FROM (SELECT greatest(min_scale(amount), min_scale(held)) AS scale FROM assets WHERE name = @symbol
+		      UNION ALL
+		      SELECT min_scale(amount) FROM scheduled_transactions WHERE asset_name = @symbol AND status = 'pending'
+		      UNION ALL
+		      SELECT min_scale(amount) FROM recurring_schedules
+		      WHERE asset_name = @symbol AND status IN ('active', 'paused')) stored`,
+		sql.Named("symbol", symbol)).Scan(&scale).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return scale, nil
+}
@@ -0,0 +1,55 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+	"regexp"
+)
+
+// MaxDecimals is the largest scale the amount columns can store
+const MaxDecimals = 18
+
+var symbolPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,16}$`)
+
+type CreateDefinitionRequest struct {
+	Symbol          string          `json:"symbol"`
+	DisplayName     string          `json:"display_name"`
+	Decimals        int32           `json:"decimals"`
+	MinTransferUnit decimal.Decimal `json:"min_transfer_unit"`
+	Enabled         *bool           `json:"enabled"`
+}
+
+func (r CreateDefinitionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Symbol, validation.Required, validation.Match(symbolPattern)),
+		validation.Field(&r.DisplayName, validation.Required),
+		validation.Field(&r.Decimals, validation.Min(0), validation.Max(MaxDecimals)),
+		validation.Field(&r.MinTransferUnit, common.NonNegativeAmount, fitsDecimals(r.Decimals)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "asset definition create validation error")
+}
+
+// fitsDecimals validates that a minimum transfer unit does not have more fractional digits than the asset
+func fitsDecimals(decimals int32) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		var unit decimal.Decimal
+		switch v := value.(type) {
+		case decimal.Decimal:
+			unit = v
+		case *decimal.Decimal:
+			if v == nil {
+				return nil
+			}
+			unit = *v
+		}
+
+		if !unit.Equal(unit.Truncate(decimals)) {
+			return errors.New("must not have more decimal places than decimals")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,7 @@
+package request
+
+type GetDefinitionsParams struct {
+	ID      []uint   `json:"id" schema:"id"`
+	Symbol  []string `json:"symbol" schema:"symbol"`
+	Enabled *bool    `json:"enabled" schema:"enabled"`
+}
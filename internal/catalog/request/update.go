@@ -0,0 +1,25 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+type UpdateDefinitionRequest struct {
+	DisplayName     *string          `json:"display_name"`
+	Decimals        *int32           `json:"decimals"`
+	MinTransferUnit *decimal.Decimal `json:"min_transfer_unit"`
+	Enabled         *bool            `json:"enabled"`
+}
+
+func (r UpdateDefinitionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.DisplayName, validation.NilOrNotEmpty),
+		validation.Field(&r.Decimals, validation.Min(0), validation.Max(MaxDecimals)),
+		validation.Field(&r.MinTransferUnit, common.NonNegativeAmount),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "asset definition update validation error")
+}
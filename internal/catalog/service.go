@@ -0,0 +1,214 @@
+package catalog
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	"github.com/safayildirim/asset-management-service/internal/catalog/request"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+	"strings"
+)
+
+type Service interface {
+	CreateDefinition(ctx context.Context, request *request.CreateDefinitionRequest) (*entity.AssetDefinition, error)
+	GetDefinitions(ctx context.Context, request *request.GetDefinitionsParams) ([]*entity.AssetDefinition, error)
+	GetDefinition(ctx context.Context, symbol string) (*entity.AssetDefinition, error)
+	UpdateDefinition(ctx context.Context, symbol string,
+		request *request.UpdateDefinitionRequest) (*entity.AssetDefinition, error)
+	DeleteDefinition(ctx context.Context, symbol string) error
+	ValidateAmount(ctx context.Context, symbol string, amount decimal.Decimal) (*entity.AssetDefinition, error)
+}
+
+type service struct {
+	catalogRepository Repository
+}
+
+func NewService(catalogRepository Repository) Service {
+	return &service{catalogRepository: catalogRepository}
+}
+
+// NormalizeSymbol converts a user supplied asset name into its canonical catalogue symbol
+func NormalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
+
+func (s *service) CreateDefinition(ctx context.Context,
+	request *request.CreateDefinitionRequest) (*entity.AssetDefinition, error) {
+	enabled := true
+	if request.Enabled != nil {
+		enabled = *request.Enabled
+	}
+
+	item := entity.AssetDefinition{
+		Symbol:          NormalizeSymbol(request.Symbol),
+		DisplayName:     request.DisplayName,
+		Decimals:        request.Decimals,
+		MinTransferUnit: request.MinTransferUnit,
+		Enabled:         enabled,
+	}
+	return s.catalogRepository.CreateDefinition(ctx, &item)
+}
+
+func (s *service) GetDefinitions(ctx context.Context,
+	request *request.GetDefinitionsParams) ([]*entity.AssetDefinition, error) {
+	symbols := make([]string, 0, len(request.Symbol))
+	for _, symbol := range request.Symbol {
+		symbols = append(symbols, NormalizeSymbol(symbol))
+	}
+
+	filters := entity.Filters{
+		ID:      request.ID,
+		Symbol:  symbols,
+		Enabled: null.BoolFromPtr(request.Enabled),
+	}
+	return s.catalogRepository.GetDefinitions(ctx, filters)
+}
+
+// GetDefinition fetches a single asset definition by its symbol.
+//
+// Errors:
+//   - ErrDefinitionNotFound: If no definition exists for the symbol.
+func (s *service) GetDefinition(ctx context.Context, symbol string) (*entity.AssetDefinition, error) {
+	definitions, err := s.catalogRepository.GetDefinitions(ctx, entity.Filters{
+		Symbol: []string{NormalizeSymbol(symbol)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definitions) == 0 {
+		return nil, ErrDefinitionNotFound
+	}
+
+	return definitions[0], nil
+}
+
+// UpdateDefinition applies the provided fields to an existing asset definition.
+//
+// Parameters:
+//   - ctx: The context for managing request lifecycle and cancellation.
+//   - symbol: The symbol of the definition to update.
+//   - request: The fields to change; nil fields are left untouched.
+//
+// Errors:
+//   - ErrDefinitionNotFound: If no definition exists for the symbol.
+//   - ErrAmountPrecision: If the resulting minimum transfer unit does not fit the resulting decimals.
+//   - ErrDecimalsBelowStoredScale: If the decimals are lowered below the precision of amounts already stored.
+func (s *service) UpdateDefinition(ctx context.Context, symbol string,
+	request *request.UpdateDefinitionRequest) (*entity.AssetDefinition, error) {
+	definition, err := s.GetDefinition(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// Balances and pending amounts must stay expressible with the asset's decimals
+	if request.Decimals != nil && *request.Decimals < definition.Decimals {
+		scale, err := s.catalogRepository.GetStoredScale(ctx, definition.Symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		if *request.Decimals < scale {
+			return nil, ErrDecimalsBelowStoredScale.WithDetails(apperror.Details{"stored_decimals": scale})
+		}
+	}
+
+	if request.DisplayName != nil {
+		definition.DisplayName = *request.DisplayName
+	}
+	if request.Decimals != nil {
+		definition.Decimals = *request.Decimals
+	}
+	if request.MinTransferUnit != nil {
+		definition.MinTransferUnit = *request.MinTransferUnit
+	}
+	if request.Enabled != nil {
+		definition.Enabled = *request.Enabled
+	}
+
+	// Reject combinations where the minimum unit cannot be expressed with the asset's decimals
+	if !fitsDecimals(definition.MinTransferUnit, definition.Decimals) {
+		return nil, ErrAmountPrecision
+	}
+
+	err = s.catalogRepository.UpdateDefinition(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// DeleteDefinition removes an asset from the catalogue. An asset that wallets still hold, or that pending transactions
+// or active or paused recurring schedules are about to move, cannot be removed, as their balances would become unusable
+// and their transactions would fail; it can be disabled instead.
+//
+// Errors:
+//   - ErrDefinitionNotFound: If no definition exists for the symbol.
+//   - ErrDefinitionInUse: If a wallet holds a non-zero balance of the asset, or a pending transaction or an active or
+//     paused recurring schedule moves it.
+func (s *service) DeleteDefinition(ctx context.Context, symbol string) error {
+	definition, err := s.GetDefinition(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	inUse, err := s.catalogRepository.IsInUse(ctx, definition.Symbol)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return ErrDefinitionInUse
+	}
+
+	return s.catalogRepository.DeleteDefinition(ctx, definition.ID)
+}
+
+// ValidateAmount checks that an asset symbol is known and enabled and that the amount respects its precision.
+//
+// Parameters:
+//   - ctx: The context for managing request lifecycle and cancellation.
+//   - symbol: The asset symbol as supplied by the caller; it is normalized before the lookup.
+//   - amount: The amount that is about to be moved. Zero amounts skip the minimum transfer unit check.
+//
+// Returns:
+//   - The matching asset definition, whose Symbol is the canonical asset name to persist.
+//   - An error if the asset or the amount is not acceptable.
+//
+// Errors:
+//   - ErrUnknownAsset: If no definition exists for the symbol.
+//   - ErrAssetDisabled: If the definition exists but is disabled.
+//   - ErrAmountPrecision: If the amount has more fractional digits than the asset's decimals.
+//   - ErrAmountBelowMinimum: If a positive amount is smaller than the asset's minimum transfer unit.
+func (s *service) ValidateAmount(ctx context.Context, symbol string,
+	amount decimal.Decimal) (*entity.AssetDefinition, error) {
+	definition, err := s.GetDefinition(ctx, symbol)
+	if err != nil {
+		if errors.Is(err, ErrDefinitionNotFound) {
+			return nil, ErrUnknownAsset
+		}
+		return nil, err
+	}
+
+	if !definition.Enabled {
+		return nil, ErrAssetDisabled
+	}
+
+	if !fitsDecimals(amount, definition.Decimals) {
+		return nil, ErrAmountPrecision
+	}
+
+	if amount.IsPositive() && amount.LessThan(definition.MinTransferUnit) {
+		return nil, ErrAmountBelowMinimum
+	}
+
+	return definition, nil
+}
+
+// fitsDecimals reports whether the amount can be represented with the given number of fractional digits
+func fitsDecimals(amount decimal.Decimal, decimals int32) bool {
+	return amount.Equal(amount.Truncate(decimals))
+}
@@ -0,0 +1,349 @@
+package catalog
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/internal/catalog/request"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestService_CreateDefinition(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name           string
+		request        *request.CreateDefinitionRequest
+		mockReturn     *entity.AssetDefinition
+		mockError      error
+		expectedSymbol string
+		expectedEnable bool
+		expectedError  error
+	}{
+		{
+			name: "when enabled flag is omitted then should create enabled definition with canonical symbol",
+			request: &request.CreateDefinitionRequest{
+				Symbol:          " btc ",
+				DisplayName:     "Bitcoin",
+				Decimals:        8,
+				MinTransferUnit: decimal.RequireFromString("0.00000001"),
+			},
+			mockReturn:     &entity.AssetDefinition{ID: 1, Symbol: "BTC"},
+			expectedSymbol: "BTC",
+			expectedEnable: true,
+		},
+		{
+			name: "when enabled flag is false then should create disabled definition",
+			request: &request.CreateDefinitionRequest{
+				Symbol:      "ETH",
+				DisplayName: "Ethereum",
+				Decimals:    18,
+				Enabled:     &disabled,
+			},
+			mockReturn:     &entity.AssetDefinition{ID: 2, Symbol: "ETH"},
+			expectedSymbol: "ETH",
+			expectedEnable: false,
+		},
+		{
+			name: "when repository returns error then should return error",
+			request: &request.CreateDefinitionRequest{
+				Symbol:      "BTC",
+				DisplayName: "Bitcoin",
+				Decimals:    8,
+			},
+			mockError:      ErrDuplicateDefinition,
+			expectedSymbol: "BTC",
+			expectedEnable: true,
+			expectedError:  ErrDuplicateDefinition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := catalogmock.NewMockCatalogRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().CreateDefinition(mock.Anything, mock.MatchedBy(func(item *entity.AssetDefinition) bool {
+				return item.Symbol == tt.expectedSymbol && item.Enabled == tt.expectedEnable
+			})).Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.CreateDefinition(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
+			}
+		})
+	}
+}
+
+func TestService_UpdateDefinition(t *testing.T) {
+	displayName := "Bitcoin Core"
+	decimals := int32(2)
+	enabled := false
+
+	tests := []struct {
+		name           string
+		symbol         string
+		request        *request.UpdateDefinitionRequest
+		mockDefinition []*entity.AssetDefinition
+		mockGetErr     error
+		mockScale      bool
+		storedScale    int32
+		mockUpdate     bool
+		mockUpdateErr  error
+		expectedResult *entity.AssetDefinition
+		expectedError  error
+	}{
+		{
+			name:    "when fields are provided then should update only those fields",
+			symbol:  "btc",
+			request: &request.UpdateDefinitionRequest{DisplayName: &displayName, Enabled: &enabled},
+			mockDefinition: []*entity.AssetDefinition{
+				{ID: 1, Symbol: "BTC", DisplayName: "Bitcoin", Decimals: 8, Enabled: true},
+			},
+			mockUpdate: true,
+			expectedResult: &entity.AssetDefinition{
+				ID: 1, Symbol: "BTC", DisplayName: "Bitcoin Core", Decimals: 8, Enabled: false,
+			},
+		},
+		{
+			name:    "when decimals no longer fit the minimum transfer unit then should return error",
+			symbol:  "BTC",
+			request: &request.UpdateDefinitionRequest{Decimals: &decimals},
+			mockDefinition: []*entity.AssetDefinition{
+				{ID: 1, Symbol: "BTC", Decimals: 8, MinTransferUnit: decimal.RequireFromString("0.00000001")},
+			},
+			mockScale:     true,
+			expectedError: ErrAmountPrecision,
+		},
+		{
+			name:    "when decimals are lowered below the precision of stored amounts then should return error",
+			symbol:  "BTC",
+			request: &request.UpdateDefinitionRequest{Decimals: &decimals},
+			mockDefinition: []*entity.AssetDefinition{
+				{ID: 1, Symbol: "BTC", Decimals: 8, MinTransferUnit: decimal.RequireFromString("0.01")},
+			},
+			mockScale:     true,
+			storedScale:   5,
+			expectedError: ErrDecimalsBelowStoredScale,
+		},
+		{
+			name:    "when decimals are lowered within the precision of stored amounts then should update them",
+			symbol:  "BTC",
+			request: &request.UpdateDefinitionRequest{Decimals: &decimals},
+			mockDefinition: []*entity.AssetDefinition{
+				{ID: 1, Symbol: "BTC", Decimals: 8, MinTransferUnit: decimal.RequireFromString("0.01")},
+			},
+			mockScale:   true,
+			storedScale: 2,
+			mockUpdate:  true,
+			expectedResult: &entity.AssetDefinition{
+				ID: 1, Symbol: "BTC", Decimals: 2, MinTransferUnit: decimal.RequireFromString("0.01"),
+			},
+		},
+		{
+			name:           "when definition does not exist then should return error",
+			symbol:         "DOGE",
+			request:        &request.UpdateDefinitionRequest{Enabled: &enabled},
+			mockDefinition: []*entity.AssetDefinition{},
+			expectedError:  ErrDefinitionNotFound,
+		},
+		{
+			name:           "when repository returns error on update then should return error",
+			symbol:         "BTC",
+			request:        &request.UpdateDefinitionRequest{Enabled: &enabled},
+			mockDefinition: []*entity.AssetDefinition{{ID: 1, Symbol: "BTC", Decimals: 8, Enabled: true}},
+			mockUpdate:     true,
+			mockUpdateErr:  errors.New("update error"),
+			expectedError:  errors.New("update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := catalogmock.NewMockCatalogRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetDefinitions(mock.Anything, entity.Filters{Symbol: []string{NormalizeSymbol(tt.symbol)}}).
+				Return(tt.mockDefinition, tt.mockGetErr).Once()
+
+			if tt.mockScale {
+				mockRepository.EXPECT().GetStoredScale(mock.Anything, "BTC").Return(tt.storedScale, nil).Once()
+			}
+
+			if tt.mockUpdate {
+				mockRepository.EXPECT().UpdateDefinition(mock.Anything, mock.Anything).Return(tt.mockUpdateErr).Once()
+			}
+
+			result, err := s.UpdateDefinition(context.Background(), tt.symbol, tt.request)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_DeleteDefinition(t *testing.T) {
+	tests := []struct {
+		name           string
+		symbol         string
+		mockDefinition []*entity.AssetDefinition
+		mockInUse      bool
+		inUse          bool
+		mockDelete     bool
+		expectedError  error
+	}{
+		{
+			name:           "when the asset is not in use then should delete the definition",
+			symbol:         "btc",
+			mockDefinition: []*entity.AssetDefinition{{ID: 1, Symbol: "BTC"}},
+			mockInUse:      true,
+			mockDelete:     true,
+		},
+		{
+			name:           "when the asset is in use then should return in use error",
+			symbol:         "BTC",
+			mockDefinition: []*entity.AssetDefinition{{ID: 1, Symbol: "BTC"}},
+			mockInUse:      true,
+			inUse:          true,
+			expectedError:  ErrDefinitionInUse,
+		},
+		{
+			name:           "when definition does not exist then should return not found error",
+			symbol:         "DOGE",
+			mockDefinition: []*entity.AssetDefinition{},
+			expectedError:  ErrDefinitionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := catalogmock.NewMockCatalogRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetDefinitions(mock.Anything,
+				entity.Filters{Symbol: []string{NormalizeSymbol(tt.symbol)}}).Return(tt.mockDefinition, nil).Once()
+
+			if tt.mockInUse {
+				mockRepository.EXPECT().IsInUse(mock.Anything, "BTC").Return(tt.inUse, nil).Once()
+			}
+
+			if tt.mockDelete {
+				mockRepository.EXPECT().DeleteDefinition(mock.Anything, uint(1)).Return(nil).Once()
+			}
+
+			err := s.DeleteDefinition(context.Background(), tt.symbol)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_ValidateAmount(t *testing.T) {
+	btc := &entity.AssetDefinition{
+		ID:              1,
+		Symbol:          "BTC",
+		Decimals:        8,
+		MinTransferUnit: decimal.RequireFromString("0.0001"),
+		Enabled:         true,
+	}
+
+	tests := []struct {
+		name           string
+		symbol         string
+		amount         decimal.Decimal
+		mockDefinition []*entity.AssetDefinition
+		mockErr        error
+		expectedResult *entity.AssetDefinition
+		expectedError  error
+	}{
+		{
+			name:           "when amount fits the asset then should return definition",
+			symbol:         "btc",
+			amount:         decimal.RequireFromString("0.12345678"),
+			mockDefinition: []*entity.AssetDefinition{btc},
+			expectedResult: btc,
+		},
+		{
+			name:           "when amount is zero then should skip minimum transfer unit check",
+			symbol:         "BTC",
+			amount:         decimal.Zero,
+			mockDefinition: []*entity.AssetDefinition{btc},
+			expectedResult: btc,
+		},
+		{
+			name:           "when symbol is unknown then should return unknown asset error",
+			symbol:         "Bitcoin",
+			amount:         decimal.NewFromInt(1),
+			mockDefinition: []*entity.AssetDefinition{},
+			expectedError:  ErrUnknownAsset,
+		},
+		{
+			name:   "when asset is disabled then should return disabled error",
+			symbol: "BTC",
+			amount: decimal.NewFromInt(1),
+			mockDefinition: []*entity.AssetDefinition{
+				{ID: 1, Symbol: "BTC", Decimals: 8, Enabled: false},
+			},
+			expectedError: ErrAssetDisabled,
+		},
+		{
+			name:           "when amount has more decimals than the asset then should return precision error",
+			symbol:         "BTC",
+			amount:         decimal.RequireFromString("0.123456789"),
+			mockDefinition: []*entity.AssetDefinition{btc},
+			expectedError:  ErrAmountPrecision,
+		},
+		{
+			name:           "when amount is below minimum transfer unit then should return minimum error",
+			symbol:         "BTC",
+			amount:         decimal.RequireFromString("0.00009999"),
+			mockDefinition: []*entity.AssetDefinition{btc},
+			expectedError:  ErrAmountBelowMinimum,
+		},
+		{
+			name:          "when repository returns error then should return error",
+			symbol:        "BTC",
+			amount:        decimal.NewFromInt(1),
+			mockErr:       errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := catalogmock.NewMockCatalogRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetDefinitions(mock.Anything, entity.Filters{Symbol: []string{NormalizeSymbol(tt.symbol)}}).
+				Return(tt.mockDefinition, tt.mockErr).Once()
+
+			result, err := s.ValidateAmount(context.Background(), tt.symbol, tt.amount)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -1,5 +1,17 @@
 package common
 
 type Response struct {
-	Data any `json:"data"`
+	Data       any         `json:"data"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// Pagination tells clients of a listing how to fetch the next page; NextCursor is omitted on the last page
+type Pagination struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// NewPageResponse wraps a page of a listing into a response carrying its pagination
+func NewPageResponse[T any](page *Page[T]) Response {
+	return Response{Data: page.Items, Pagination: &Pagination{Limit: page.Limit, NextCursor: page.NextCursor}}
 }
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"net"
+)
+
+// nonPublicNetworks are ranges that are not reachable from the internet but are not reported by the net.IP
+// predicates, i.e. "this network", carrier-grade NAT and benchmarking ranges
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+}
+
+// IsPublicIP reports whether an address is reachable from the internet, i.e. neither unspecified, loopback, private,
+// link-local (e.g. the cloud metadata address 169.254.169.254) nor multicast
+func IsPublicIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return network
+}
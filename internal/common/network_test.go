@@ -0,0 +1,44 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		ips      []string
+		expected bool
+	}{
+		{
+			name:     "when address is reachable from the internet then should return true",
+			ips:      []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"},
+			expected: true,
+		},
+		{
+			name:     "when address is unspecified or loopback then should return false",
+			ips:      []string{"0.0.0.0", "0.1.2.3", "127.0.0.1", "::1", "::ffff:127.0.0.1"},
+			expected: false,
+		},
+		{
+			name:     "when address is private then should return false",
+			ips:      []string{"10.0.0.1", "172.16.0.1", "192.168.1.1", "100.64.0.1", "fd00::1"},
+			expected: false,
+		},
+		{
+			name:     "when address is link-local or multicast then should return false",
+			ips:      []string{"169.254.169.254", "fe80::1", "224.0.0.1"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, ip := range tt.ips {
+				assert.Equal(t, tt.expected, IsPublicIP(net.ParseIP(ip)), ip)
+			}
+		})
+	}
+}
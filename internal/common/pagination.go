@@ -0,0 +1,173 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"gorm.io/gorm"
+	"net/http"
+	"strings"
+)
+
+const (
+	// DefaultPageLimit is the page size of listings that do not ask for a limit
+	DefaultPageLimit = 50
+	// MaxPageLimit is the largest page size a listing can ask for
+	MaxPageLimit = 500
+)
+
+var ErrInvalidCursor = apperror.New(apperror.CodeInvalidRequest, http.StatusBadRequest, "invalid cursor")
+
+// Sort orders a listing by a field, descending when Desc is set. Ties are broken by ID in the same direction, so the
+// order is total and a cursor always points at a single position.
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses a sort parameter such as "amount" or "-created_at"; an empty value sorts by ID ascending
+func ParseSort(value string) Sort {
+	if value == "" {
+		return Sort{Field: "id"}
+	}
+
+	if strings.HasPrefix(value, "-") {
+		return Sort{Field: strings.TrimPrefix(value, "-"), Desc: true}
+	}
+
+	return Sort{Field: value}
+}
+
+// String formats the sort back into its parameter form
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+
+	return s.Field
+}
+
+// SortRule validates a sort parameter against the fields a listing can be sorted by, in either direction
+func SortRule(fields ...string) validation.Rule {
+	values := make([]interface{}, 0, 2*len(fields))
+	for _, field := range fields {
+		values = append(values, field, "-"+field)
+	}
+
+	return validation.In(values...).Error("must be one of " + strings.Join(fields, ", ") +
+		", optionally prefixed with - for descending order")
+}
+
+// Cursor points right after the last item of a page by the value of its sort field and its ID. The sort is part of
+// the cursor so that it cannot be used with a different order.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v,omitempty"`
+	ID    uint   `json:"id"`
+}
+
+// Encode turns the cursor into the opaque token handed to clients
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor token issued for the given sort, returning ErrInvalidCursor if it is malformed or
+// was issued for another order
+func DecodeCursor(token string, sort Sort) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.Sort != sort.String() || cursor.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// PageQuery describes the page of a listing to fetch
+type PageQuery struct {
+	Limit int
+	Sort  Sort
+	After *Cursor
+}
+
+// NewPageQuery builds the page query from the limit, sort and cursor parameters of a listing request
+func NewPageQuery(limit int, sort string, cursor string) (*PageQuery, error) {
+	page := PageQuery{Limit: limit, Sort: ParseSort(sort)}
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageLimit
+	}
+
+	if cursor != "" {
+		after, err := DecodeCursor(cursor, page.Sort)
+		if err != nil {
+			return nil, err
+		}
+		page.After = after
+	}
+
+	return &page, nil
+}
+
+// SortColumn is a column a listing can be sorted by, along with the SQL type cursor values are cast to
+type SortColumn struct {
+	Name string
+	Type string
+}
+
+// Paginate applies keyset pagination to a query: it selects the rows after the cursor, orders them by the sort column
+// and ID, and fetches one row more than the limit so that NewPage can tell whether another page follows
+func Paginate(query *gorm.DB, page *PageQuery, columns map[string]SortColumn) *gorm.DB {
+	column, ok := columns[page.Sort.Field]
+	if !ok {
+		column = SortColumn{Name: "id"}
+	}
+
+	direction, comparison := "ASC", ">"
+	if page.Sort.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if page.After != nil {
+		if column.Name == "id" {
+			query = query.Where(fmt.Sprintf("id %s ?", comparison), page.After.ID)
+		} else {
+			query = query.Where(fmt.Sprintf("(%s, id) %s (CAST(? AS %s), ?)", column.Name, comparison, column.Type),
+				page.After.Value, page.After.ID)
+		}
+	}
+
+	if column.Name != "id" {
+		query = query.Order(column.Name + " " + direction)
+	}
+
+	return query.Order("id " + direction).Limit(page.Limit + 1)
+}
+
+// Page is a page of a listing along with the cursor of the next page, which is empty on the last page
+type Page[T any] struct {
+	Items      []T
+	Limit      int
+	NextCursor string
+}
+
+// NewPage trims the items fetched by Paginate to the page limit and builds the cursor of the next page from the last
+// item kept, using key to read its sort value and ID
+func NewPage[T any](items []T, page *PageQuery, key func(item T) (string, uint)) *Page[T] {
+	result := Page[T]{Items: items, Limit: page.Limit}
+	if len(items) <= page.Limit {
+		return &result
+	}
+
+	result.Items = items[:page.Limit]
+	value, id := key(result.Items[page.Limit-1])
+	result.NextCursor = Cursor{Sort: page.Sort.String(), Value: value, ID: id}.Encode()
+
+	return &result
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          string
+		sort           Sort
+		expectedResult *Cursor
+		expectedError  error
+	}{
+		{
+			name:           "when cursor was issued for the sort then should decode it",
+			token:          Cursor{Sort: "-amount", Value: "1.5", ID: 7}.Encode(),
+			sort:           Sort{Field: "amount", Desc: true},
+			expectedResult: &Cursor{Sort: "-amount", Value: "1.5", ID: 7},
+		},
+		{
+			name:          "when cursor was issued for another direction then should return invalid cursor error",
+			token:         Cursor{Sort: "amount", Value: "1.5", ID: 7}.Encode(),
+			sort:          Sort{Field: "amount", Desc: true},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "when cursor is not base64 then should return invalid cursor error",
+			token:         "%%%",
+			sort:          Sort{Field: "id"},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "when cursor has no ID then should return invalid cursor error",
+			token:         Cursor{Sort: "id"}.Encode(),
+			sort:          Sort{Field: "id"},
+			expectedError: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecodeCursor(tt.token, tt.sort)
+
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+
+	columns := map[string]SortColumn{
+		"id":     {Name: "id"},
+		"amount": {Name: "amount", Type: "numeric"},
+	}
+
+	tests := []struct {
+		name         string
+		page         *PageQuery
+		expectedSQL  string
+		expectedVars []interface{}
+	}{
+		{
+			name:         "when the first page is sorted by ID then should only order and limit",
+			page:         &PageQuery{Limit: 10, Sort: Sort{Field: "id"}},
+			expectedSQL:  `SELECT * FROM "assets" ORDER BY id ASC LIMIT $1`,
+			expectedVars: []interface{}{11},
+		},
+		{
+			name:         "when a later page is sorted by ID then should seek after the cursor ID",
+			page:         &PageQuery{Limit: 10, Sort: Sort{Field: "id", Desc: true}, After: &Cursor{ID: 4}},
+			expectedSQL:  `SELECT * FROM "assets" WHERE id < $1 ORDER BY id DESC LIMIT $2`,
+			expectedVars: []interface{}{uint(4), 11},
+		},
+		{
+			name: "when a later page is sorted by another field then should seek after the cursor value and ID",
+			page: &PageQuery{Limit: 2, Sort: Sort{Field: "amount"}, After: &Cursor{Value: "5", ID: 3}},
+			expectedSQL: `SELECT * FROM "assets" WHERE (amount, id) > (CAST($1 AS numeric), $2) ` +
+				`ORDER BY amount ASC,id ASC LIMIT $3`,
+			expectedVars: []interface{}{"5", uint(3), 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result []map[string]interface{}
+			statement := Paginate(db.Table("assets"), tt.page, columns).Find(&result).Statement
+
+			assert.Equal(t, tt.expectedSQL, statement.SQL.String())
+			assert.Equal(t, tt.expectedVars, statement.Vars)
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	key := func(item int) (string, uint) {
+		return "", uint(item)
+	}
+
+	tests := []struct {
+		name           string
+		items          []int
+		page           *PageQuery
+		expectedResult *Page[int]
+	}{
+		{
+			name:           "when no more items than the limit were fetched then should return the last page",
+			items:          []int{1, 2},
+			page:           &PageQuery{Limit: 2, Sort: Sort{Field: "id"}},
+			expectedResult: &Page[int]{Items: []int{1, 2}, Limit: 2},
+		},
+		{
+			name:  "when more items than the limit were fetched then should return the cursor of the last item kept",
+			items: []int{1, 2, 3},
+			page:  &PageQuery{Limit: 2, Sort: Sort{Field: "id"}},
+			expectedResult: &Page[int]{Items: []int{1, 2}, Limit: 2,
+				NextCursor: Cursor{Sort: "id", ID: 2}.Encode()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, NewPage(tt.items, tt.page, key))
+		})
+	}
+}
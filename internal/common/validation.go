@@ -0,0 +1,50 @@
+package common
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// PositiveAmount validates that a decimal amount is strictly greater than zero
+var PositiveAmount = validation.By(func(value interface{}) error {
+	amount, ok, err := toDecimal(value)
+	if err != nil || !ok {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return errors.New("must be greater than zero")
+	}
+
+	return nil
+})
+
+// NonNegativeAmount validates that a decimal amount is zero or greater
+var NonNegativeAmount = validation.By(func(value interface{}) error {
+	amount, ok, err := toDecimal(value)
+	if err != nil || !ok {
+		return err
+	}
+
+	if amount.IsNegative() {
+		return errors.New("must not be negative")
+	}
+
+	return nil
+})
+
+// toDecimal extracts a decimal from a validated field, reporting false for nil pointers so optional fields are skipped
+func toDecimal(value interface{}) (decimal.Decimal, bool, error) {
+	switch v := value.(type) {
+	case decimal.Decimal:
+		return v, true, nil
+	case *decimal.Decimal:
+		if v == nil {
+			return decimal.Decimal{}, false, nil
+		}
+		return *v, true, nil
+	}
+
+	return decimal.Decimal{}, false, errors.New("must be a decimal amount")
+}
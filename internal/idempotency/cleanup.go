@@ -0,0 +1,32 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"time"
+)
+
+// StartCleanup periodically removes expired idempotency records until the context is cancelled
+func StartCleanup(ctx context.Context, repository Repository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := repository.DeleteExpired(ctx, common.Now())
+			if err != nil {
+				log.Logger.Error("failed to delete expired idempotency records", zap.Error(err))
+				continue
+			}
+
+			if deleted > 0 {
+				log.Logger.Info("deleted expired idempotency records", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
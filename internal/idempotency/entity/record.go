@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Record stores the outcome of a request made with an Idempotency-Key header. A record without a StatusCode belongs
+// to a request that is still being processed.
+type Record struct {
+	ID           uint        `json:"id"`
+	CreatedAt    time.Time   `json:"created_at"`
+	ExpiresAt    time.Time   `json:"expires_at"`
+	Key          string      `json:"key"`
+	Method       string      `json:"method"`
+	Path         string      `json:"path"`
+	RequestHash  string      `json:"request_hash"`
+	StatusCode   null.Int    `json:"status_code"`
+	ContentType  null.String `json:"content_type"`
+	ResponseBody []byte      `json:"-"`
+}
+
+func (Record) TableName() string {
+	return "idempotency_keys"
+}
+
+// Completed reports whether the original response has been stored and can be replayed
+func (r Record) Completed() bool {
+	return r.StatusCode.Valid
+}
@@ -0,0 +1,10 @@
+package idempotency
+
+import "github.com/pkg/errors"
+
+var (
+	ErrKeyReused      = errors.New("idempotency key was already used with a different request")
+	ErrKeyInProgress  = errors.New("a request with this idempotency key is still being processed")
+	ErrKeyTooLong     = errors.New("idempotency key must not be longer than 255 characters")
+	ErrRecordNotFound = errors.New("idempotency record not found")
+)
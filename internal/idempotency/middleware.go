@@ -0,0 +1,179 @@
+package idempotency
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/idempotency/entity"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderReplayed       = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+// Middleware makes POST and PATCH requests carrying an Idempotency-Key header safe to retry.
+//
+// The first request with a given key is executed and its response is stored for the given ttl. A retry with the same
+// key and an identical request receives the stored response without the handler being executed again. Reusing the
+// key for a different request is rejected with 422, and a retry that arrives while the original request is still
+// being processed is rejected with 409. Server errors (5xx) and panics are not stored, so the key is released and the
+// request can be retried.
+//
+// While the request is processed the key is only reserved for lockTimeout, so that a key left behind by an instance
+// that stopped mid-request can be claimed again by the next retry instead of blocking it for the whole ttl.
+//
+// Requests without the header are passed through unchanged.
+func Middleware(repository Repository, ttl, lockTimeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			key := req.Header.Get(HeaderIdempotencyKey)
+			if key == "" || (req.Method != http.MethodPost && req.Method != http.MethodPatch) {
+				return next(c)
+			}
+
+			if len(key) > maxKeyLength {
+				return echo.NewHTTPError(http.StatusBadRequest, ErrKeyTooLong.Error())
+			}
+
+			// Read the body so that it can be hashed, then restore it for the handler
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			now := common.Now()
+			record, reserved, err := repository.Reserve(req.Context(), &entity.Record{
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(lockTimeout),
+				Key:         key,
+				Method:      req.Method,
+				Path:        req.URL.Path,
+				RequestHash: hashRequest(req.Method, req.URL.Path, body),
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+
+			if !reserved {
+				return replay(c, record, hashRequest(req.Method, req.URL.Path, body))
+			}
+
+			// Record the outcome even if the client went away while the handler ran, so that the key is not left
+			// reserved until it expires
+			ctx := context.WithoutCancel(req.Context())
+
+			// Release the key if the handler panics, then let the panic reach the recover middleware
+			defer func() {
+				if r := recover(); r != nil {
+					release(ctx, repository, record)
+					panic(r)
+				}
+			}()
+
+			// Capture the response while it is written to the client
+			dump := new(bytes.Buffer)
+			writer := &bodyDumpResponseWriter{Writer: io.MultiWriter(c.Response().Writer, dump),
+				ResponseWriter: c.Response().Writer}
+			c.Response().Writer = writer
+
+			if err = next(c); err != nil {
+				c.Error(err)
+			}
+
+			if c.Response().Status >= http.StatusInternalServerError {
+				release(ctx, repository, record)
+				return nil
+			}
+
+			record.ExpiresAt = common.Now().Add(ttl)
+			record.StatusCode = null.IntFrom(int64(c.Response().Status))
+			record.ContentType = null.StringFrom(c.Response().Header().Get(echo.HeaderContentType))
+			record.ResponseBody = dump.Bytes()
+			err = repository.Complete(ctx, record)
+			if err != nil {
+				log.Logger.Error("failed to store idempotent response", zap.String("key", key), zap.Error(err))
+			}
+
+			return nil
+		}
+	}
+}
+
+// release frees the key of a request whose response is not stored
+func release(ctx context.Context, repository Repository, record *entity.Record) {
+	err := repository.Release(ctx, record)
+	if err != nil {
+		log.Logger.Error("failed to release idempotency key", zap.String("key", record.Key), zap.Error(err))
+	}
+}
+
+// replay answers a retried request from the record stored for its key
+func replay(c echo.Context, record *entity.Record, requestHash string) error {
+	if record.RequestHash != requestHash {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, ErrKeyReused.Error())
+	}
+
+	if !record.Completed() {
+		return echo.NewHTTPError(http.StatusConflict, ErrKeyInProgress.Error())
+	}
+
+	c.Response().Header().Set(HeaderReplayed, "true")
+
+	return c.Blob(int(record.StatusCode.Int64), record.ContentType.String, record.ResponseBody)
+}
+
+// hashRequest fingerprints a request so that a reused key can be told apart from a genuine retry
+func hashRequest(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+type bodyDumpResponseWriter struct {
+	io.Writer
+	http.ResponseWriter
+}
+
+func (w *bodyDumpResponseWriter) WriteHeader(code int) {
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *bodyDumpResponseWriter) Write(b []byte) (int, error) {
+	return w.Writer.Write(b)
+}
+
+func (w *bodyDumpResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *bodyDumpResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+func (w *bodyDumpResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
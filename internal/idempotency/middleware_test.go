@@ -0,0 +1,288 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/idempotency/entity"
+	idempotencymock "github.com/safayildirim/asset-management-service/internal/idempotency/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMiddleware(t *testing.T) {
+	const (
+		path = "/api/assets/deposit"
+		body = `{"wallet_id":1,"asset_name":"BTC","amount":"1.5"}`
+	)
+
+	tests := []struct {
+		name             string
+		method           string
+		key              string
+		body             string
+		handlerStatus    int
+		handlerErr       error
+		handlerPanic     bool
+		clientGone       bool
+		mockReserve      bool
+		reserveRecord    *entity.Record
+		reserveCreated   bool
+		reserveErr       error
+		expectComplete   bool
+		expectRelease    bool
+		expectHandler    bool
+		expectedStatus   int
+		expectedBody     string
+		expectedReplayed bool
+	}{
+		{
+			name:           "when no idempotency key is provided then should pass the request through",
+			method:         http.MethodPost,
+			body:           body,
+			handlerStatus:  http.StatusCreated,
+			expectHandler:  true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"ok"}`,
+		},
+		{
+			name:           "when method is not POST or PATCH then should ignore the idempotency key",
+			method:         http.MethodDelete,
+			key:            "key-1",
+			handlerStatus:  http.StatusOK,
+			expectHandler:  true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":"ok"}`,
+		},
+		{
+			name:           "when key is too long then should return bad request",
+			method:         http.MethodPost,
+			key:            strings.Repeat("k", maxKeyLength+1),
+			body:           body,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   ErrKeyTooLong.Error(),
+		},
+		{
+			name:           "when key is new then should execute handler and store the response",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerStatus:  http.StatusCreated,
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectComplete: true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"ok"}`,
+		},
+		{
+			name:           "when handler returns client error then should store the error response",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerErr:     echo.NewHTTPError(http.StatusBadRequest, "invalid request"),
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectComplete: true,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid request",
+		},
+		{
+			name:           "when handler returns server error then should release the key",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerErr:     echo.NewHTTPError(http.StatusInternalServerError, "database error"),
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectRelease:  true,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "database error",
+		},
+		{
+			name:           "when client disconnects while handler runs then should still store the response",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerStatus:  http.StatusCreated,
+			clientGone:     true,
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectComplete: true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"ok"}`,
+		},
+		{
+			name:           "when client disconnects before a server error then should still release the key",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerErr:     echo.NewHTTPError(http.StatusInternalServerError, "database error"),
+			clientGone:     true,
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectRelease:  true,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "database error",
+		},
+		{
+			name:           "when handler panics then should release the key",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			handlerPanic:   true,
+			mockReserve:    true,
+			reserveCreated: true,
+			expectHandler:  true,
+			expectRelease:  true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:        "when identical request is retried then should replay the stored response",
+			method:      http.MethodPost,
+			key:         "key-1",
+			body:        body,
+			mockReserve: true,
+			reserveRecord: &entity.Record{
+				Key:          "key-1",
+				RequestHash:  hashRequest(http.MethodPost, path, []byte(body)),
+				StatusCode:   null.IntFrom(http.StatusCreated),
+				ContentType:  null.StringFrom(echo.MIMEApplicationJSON),
+				ResponseBody: []byte(`{"data":"stored"}`),
+			},
+			expectedStatus:   http.StatusCreated,
+			expectedBody:     `{"data":"stored"}`,
+			expectedReplayed: true,
+		},
+		{
+			name:        "when key is reused with a different body then should return unprocessable entity",
+			method:      http.MethodPost,
+			key:         "key-1",
+			body:        `{"wallet_id":1,"asset_name":"BTC","amount":"2"}`,
+			mockReserve: true,
+			reserveRecord: &entity.Record{
+				Key:          "key-1",
+				RequestHash:  hashRequest(http.MethodPost, path, []byte(body)),
+				StatusCode:   null.IntFrom(http.StatusCreated),
+				ResponseBody: []byte(`{"data":"stored"}`),
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   ErrKeyReused.Error(),
+		},
+		{
+			name:        "when original request is still in progress then should return conflict",
+			method:      http.MethodPost,
+			key:         "key-1",
+			body:        body,
+			mockReserve: true,
+			reserveRecord: &entity.Record{
+				Key:         "key-1",
+				RequestHash: hashRequest(http.MethodPost, path, []byte(body)),
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   ErrKeyInProgress.Error(),
+		},
+		{
+			name:           "when key cannot be reserved then should return internal server error",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           body,
+			mockReserve:    true,
+			reserveErr:     errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := idempotencymock.NewMockIdempotencyRepository(t)
+
+			if tt.mockReserve {
+				mockRepository.EXPECT().Reserve(mock.Anything, mock.MatchedBy(func(item *entity.Record) bool {
+					return item.Key == tt.key && item.Method == tt.method && item.Path == path &&
+						item.ExpiresAt.Sub(item.CreatedAt) == time.Minute
+				})).RunAndReturn(func(_ context.Context, item *entity.Record) (*entity.Record, bool, error) {
+					if tt.reserveRecord != nil {
+						return tt.reserveRecord, tt.reserveCreated, tt.reserveErr
+					}
+
+					return item, tt.reserveCreated, tt.reserveErr
+				}).Once()
+			}
+
+			// The outcome is recorded with a context that outlives the request
+			notCancelled := mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil })
+
+			if tt.expectComplete {
+				// The response is kept for the ttl once the request completed
+				mockRepository.EXPECT().Complete(notCancelled, mock.MatchedBy(func(item *entity.Record) bool {
+					return item.Key == tt.key && item.StatusCode == null.IntFrom(int64(tt.expectedStatus)) &&
+						item.ContentType == null.StringFrom(echo.MIMEApplicationJSONCharsetUTF8) &&
+						strings.Contains(string(item.ResponseBody), tt.expectedBody) &&
+						item.ExpiresAt.Sub(item.CreatedAt) >= time.Hour
+				})).Return(nil).Once()
+			}
+
+			if tt.expectRelease {
+				mockRepository.EXPECT().Release(notCancelled, mock.MatchedBy(func(item *entity.Record) bool {
+					return item.Key == tt.key && !item.Completed()
+				})).Return(nil).Once()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			handlerCalls := 0
+			handler := func(c echo.Context) error {
+				handlerCalls++
+				if tt.clientGone {
+					cancel()
+				}
+				if tt.handlerPanic {
+					panic("handler failed")
+				}
+				if tt.handlerErr != nil {
+					return tt.handlerErr
+				}
+
+				return c.JSON(tt.handlerStatus, map[string]string{"data": "ok"})
+			}
+
+			// Like in the server, panics are recovered outside the idempotency middleware
+			e := echo.New()
+			e.Use(middleware.Recover())
+			group := e.Group("/api", Middleware(mockRepository, time.Hour, time.Minute))
+			group.Add(tt.method, "/assets/deposit", handler)
+
+			req := httptest.NewRequestWithContext(ctx, tt.method, path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.key != "" {
+				req.Header.Set(HeaderIdempotencyKey, tt.key)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+			assert.Equal(t, tt.expectedReplayed, rec.Header().Get(HeaderReplayed) == "true")
+			if tt.expectHandler {
+				assert.Equal(t, 1, handlerCalls)
+			} else {
+				assert.Equal(t, 0, handlerCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/idempotency/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Repository interface {
+	Reserve(ctx context.Context, item *entity.Record) (*entity.Record, bool, error)
+	Complete(ctx context.Context, item *entity.Record) error
+	Release(ctx context.Context, item *entity.Record) error
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// Reserve claims an idempotency key for a new request. The reservation lasts until the ExpiresAt of the item, after
+// which the key can be claimed again, so that keys of requests that never finished do not stay blocked.
+//
+// Returns:
+//   - The stored record and true when the key was free (or only held by an expired record) and is now reserved.
+//   - The existing record and false when the key is already taken by an unexpired record.
+//   - An error if the database operation fails.
+func (r *repository) Reserve(ctx context.Context, item *entity.Record) (*entity.Record, bool, error) {
+	db := r.db.WithContext(ctx)
+
+	// An expired record does not block the key anymore
+	err := db.Where("key = ? AND expires_at < ?", item.Key, common.Now()).Delete(&entity.Record{}).Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	result := db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "key"}}, DoNothing: true}).Create(item)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+
+	if result.RowsAffected == 1 {
+		return item, true, nil
+	}
+
+	var existing entity.Record
+	err = db.Where("key = ?", item.Key).Take(&existing).Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &existing, false, nil
+}
+
+// Complete stores the response of the request that reserved the key, along with the time until it is kept, so that
+// retries can replay it. Only the reservation of the item is completed, not one made after it expired.
+func (r *repository) Complete(ctx context.Context, item *entity.Record) error {
+	result := r.db.WithContext(ctx).Model(&entity.Record{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+		"expires_at":    item.ExpiresAt,
+		"status_code":   item.StatusCode,
+		"content_type":  item.ContentType,
+		"response_body": item.ResponseBody,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Release frees a reserved key without storing a response, allowing the request to be retried. Only the reservation
+// of the item is released, not one made after it expired.
+func (r *repository) Release(ctx context.Context, item *entity.Record) error {
+	err := r.db.WithContext(ctx).Where("id = ?", item.ID).Delete(&entity.Record{}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the records that expired before the given time and returns how many were removed
+func (r *repository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&entity.Record{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"time"
+)
+import (
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+)
+
+// Entry is an immutable line of the asset movement journal. Every balance change is recorded as a balanced journal
+// of a debit and a credit entry sharing the same JournalID. Entries without a WalletID belong to the external
+// counterparty account that funds deposits and receives withdrawals; transfers between wallets debit the source and
+// credit the destination wallet in a single journal.
+type Entry struct {
+	ID            uint                `json:"id"`
+	CreatedAt     time.Time           `json:"created_at"`
+	JournalID     string              `json:"journal_id"`
+	WalletID      null.Int            `json:"wallet_id"`
+	AssetName     string              `json:"asset_name"`
+	Direction     Direction           `json:"direction"`
+	Amount        decimal.Decimal     `json:"amount"`
+	BalanceAfter  decimal.NullDecimal `json:"balance_after"`
+	ReferenceType ReferenceType       `json:"reference_type"`
+	ReferenceID   null.String         `json:"reference_id"`
+}
+
+func (Entry) TableName() string {
+	return "ledger_entries"
+}
+
+type Direction string
+
+const (
+	Debit  Direction = "debit"
+	Credit Direction = "credit"
+)
+
+// Opposite returns the direction of the balancing entry
+func (d Direction) Opposite() Direction {
+	if d == Debit {
+		return Credit
+	}
+	return Debit
+}
+
+type ReferenceType string
+
+const (
+	ReferenceDeposit              ReferenceType = "deposit"
+	ReferenceWithdrawal           ReferenceType = "withdrawal"
+	ReferenceScheduledTransaction ReferenceType = "scheduled_transaction"
+	ReferenceTransfer             ReferenceType = "transfer"
+	ReferenceReversal             ReferenceType = "reversal"
+)
+
+// Reference identifies the operation that caused a balance change
+type Reference struct {
+	Type ReferenceType
+	ID   string
+}
+
+// NewJournal builds the balanced pair of entries for a single balance change of a wallet.
+//
+// Parameters:
+//   - journalID: Identifier shared by both entries.
+//   - walletID: The wallet whose balance changed.
+//   - assetName: The asset whose balance changed.
+//   - direction: Credit when the wallet balance increased, debit when it decreased.
+//   - amount: The absolute amount of the change.
+//   - balanceAfter: The wallet balance once the change is applied.
+//   - reference: The originating operation.
+//
+// Returns:
+//   - The wallet entry followed by the balancing external counterparty entry.
+func NewJournal(journalID string, walletID uint, assetName string, direction Direction, amount,
+	balanceAfter decimal.Decimal, reference Reference) []*Entry {
+	referenceID := null.NewString(reference.ID, reference.ID != "")
+
+	return []*Entry{
+		{
+			JournalID:     journalID,
+			WalletID:      null.IntFrom(int64(walletID)),
+			AssetName:     assetName,
+			Direction:     direction,
+			Amount:        amount,
+			BalanceAfter:  decimal.NewNullDecimal(balanceAfter),
+			ReferenceType: reference.Type,
+			ReferenceID:   referenceID,
+		},
+		{
+			JournalID:     journalID,
+			AssetName:     assetName,
+			Direction:     direction.Opposite(),
+			Amount:        amount,
+			ReferenceType: reference.Type,
+			ReferenceID:   referenceID,
+		},
+	}
+}
+
+// NewTransferJournal builds the balanced pair of entries for a transfer between two wallets.
+//
+// Parameters:
+//   - journalID: Identifier shared by both entries.
+//   - sourceWalletID: The wallet the amount is taken from.
+//   - destinationWalletID: The wallet the amount is added to.
+//   - assetName: The transferred asset.
+//   - amount: The transferred amount.
+//   - sourceBalanceAfter: The source wallet balance once the transfer is applied.
+//   - destinationBalanceAfter: The destination wallet balance once the transfer is applied.
+//   - reference: The originating operation.
+//
+// Returns:
+//   - The debit entry of the source wallet followed by the credit entry of the destination wallet.
+func NewTransferJournal(journalID string, sourceWalletID, destinationWalletID uint, assetName string, amount,
+	sourceBalanceAfter, destinationBalanceAfter decimal.Decimal, reference Reference) []*Entry {
+	referenceID := null.NewString(reference.ID, reference.ID != "")
+
+	return []*Entry{
+		{
+			JournalID:     journalID,
+			WalletID:      null.IntFrom(int64(sourceWalletID)),
+			AssetName:     assetName,
+			Direction:     Debit,
+			Amount:        amount,
+			BalanceAfter:  decimal.NewNullDecimal(sourceBalanceAfter),
+			ReferenceType: reference.Type,
+			ReferenceID:   referenceID,
+		},
+		{
+			JournalID:     journalID,
+			WalletID:      null.IntFrom(int64(destinationWalletID)),
+			AssetName:     assetName,
+			Direction:     Credit,
+			Amount:        amount,
+			BalanceAfter:  decimal.NewNullDecimal(destinationBalanceAfter),
+			ReferenceType: reference.Type,
+			ReferenceID:   referenceID,
+		},
+	}
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type Filters struct {
+	WalletID     []uint
+	AssetName    []string
+	CreatedStart time.Time
+	CreatedEnd   time.Time
+}
@@ -0,0 +1,8 @@
+package ledger
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrUnbalancedJournal reports a programming error and is answered as an internal error, without its message
+	ErrUnbalancedJournal = errors.New("journal entries are not balanced")
+)
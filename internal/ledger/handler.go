@@ -0,0 +1,68 @@
+package ledger
+
+import (
+	"github.com/gorilla/schema"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/ledger/request"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var decoder = schema.NewDecoder()
+
+func init() {
+	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
+		return reflect.ValueOf(strings.Split(value, ","))
+	})
+	// Parse time range filters as RFC 3339 timestamps; an invalid value yields a decoding error
+	decoder.RegisterConverter(time.Time{}, func(value string) reflect.Value {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(t)
+	})
+}
+
+type Handler struct {
+	ledgerService Service
+}
+
+// NewHandler initializes a new Handler instance with the provided ledger service
+func NewHandler(ledgerService Service) *Handler {
+	return &Handler{ledgerService: ledgerService}
+}
+
+// RegisterRoutes registers the ledger API routes with the provided Echo router group
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.GET("/wallets/:id/ledger", h.GetLedger)
+}
+
+// GetLedger handles requests to list the ledger entries of a wallet
+func (h Handler) GetLedger(ctx echo.Context) error {
+	walletID, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var req request.GetLedgerParams
+	err = decoder.Decode(&req, ctx.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	entries, err := h.ledgerService.GetLedger(ctx.Request().Context(), walletID, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: entries})
+}
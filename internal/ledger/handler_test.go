@@ -0,0 +1,118 @@
+package ledger
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/asset-management-service/internal/ledger/mock"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetLedger(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		query                map[string]string
+		mockService          bool
+		mockReturnData       []*entity.Entry
+		mockReturnErr        error
+		expectErr            bool
+		expectedStatus       int
+		expectedErrorMessage string
+	}{
+		{
+			name:     "when valid time range is provided then should return entries",
+			walletID: "1",
+			query: map[string]string{
+				"start":      "2024-01-01T00:00:00Z",
+				"end":        "2024-02-01T00:00:00Z",
+				"asset_name": "BTC",
+			},
+			mockService:    true,
+			mockReturnData: []*entity.Entry{{ID: 1, AssetName: "BTC"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when wallet id is invalid then should return bad request",
+			walletID:             "invalid",
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when start is not a timestamp then should return bad request",
+			walletID:             "1",
+			query:                map[string]string{"start": "yesterday"},
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "schema: error converting value for \"start\"",
+		},
+		{
+			name:     "when end is before start then should return bad request",
+			walletID: "1",
+			query: map[string]string{
+				"start": "2024-02-01T00:00:00Z",
+				"end":   "2024-01-01T00:00:00Z",
+			},
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "end: must not be before start",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectErr:            true,
+			expectedStatus:       http.StatusInternalServerError,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().GetLedger(mock.Anything, uint(1), mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/"+tt.walletID+"/ledger", nil)
+			q := req.URL.Query()
+			for key, value := range tt.query {
+				q.Add(key, value)
+			}
+			req.URL.RawQuery = q.Encode()
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/wallets/:id/ledger")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.GetLedger(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				problem := apperror.NewProblem(err)
+				assert.Equal(t, tt.expectedStatus, problem.Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				if tt.expectedStatus == http.StatusInternalServerError {
+					// The cause of internal errors is logged but not returned to the client
+					assert.Empty(t, problem.Detail)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
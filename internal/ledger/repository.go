@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	CreateEntries(ctx context.Context, tx *gorm.DB, entries []*entity.Entry) error
+	GetEntries(ctx context.Context, filters entity.Filters) ([]*entity.Entry, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// CreateEntries appends a journal to the ledger. The entries must balance, i.e. their debits and credits must sum
+// to the same amount; the caller is expected to pass the transaction that also updates the balances.
+func (r *repository) CreateEntries(ctx context.Context, tx *gorm.DB, entries []*entity.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	sum := decimal.Zero
+	for _, e := range entries {
+		if e.Direction == entity.Debit {
+			sum = sum.Add(e.Amount)
+		} else {
+			sum = sum.Sub(e.Amount)
+		}
+	}
+	if !sum.IsZero() {
+		return ErrUnbalancedJournal
+	}
+
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	err := db.WithContext(ctx).Create(entries).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) GetEntries(ctx context.Context, filters entity.Filters) ([]*entity.Entry, error) {
+	var entries []*entity.Entry
+
+	query := r.db.WithContext(ctx).Model(&entity.Entry{})
+
+	if len(filters.WalletID) > 0 {
+		query = query.Where("wallet_id IN ?", filters.WalletID)
+	}
+	if len(filters.AssetName) > 0 {
+		query = query.Where("asset_name IN ?", filters.AssetName)
+	}
+	if !filters.CreatedStart.IsZero() {
+		query = query.Where("created_at >= ?", filters.CreatedStart)
+	}
+	if !filters.CreatedEnd.IsZero() {
+		query = query.Where("created_at <= ?", filters.CreatedEnd)
+	}
+
+	err := query.Order("id").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
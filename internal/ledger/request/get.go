@@ -0,0 +1,26 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"time"
+)
+
+type GetLedgerParams struct {
+	AssetName []string  `json:"asset_name" schema:"asset_name"`
+	Start     time.Time `json:"start" schema:"start"`
+	End       time.Time `json:"end" schema:"end"`
+}
+
+func (r GetLedgerParams) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.End, validation.By(func(value interface{}) error {
+			if !r.Start.IsZero() && !r.End.IsZero() && r.End.Before(r.Start) {
+				return errors.New("must not be before start")
+			}
+			return nil
+		})),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "ledger query validation error")
+}
@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/ledger/request"
+)
+
+type Service interface {
+	GetLedger(ctx context.Context, walletID uint, request *request.GetLedgerParams) ([]*entity.Entry, error)
+}
+
+type service struct {
+	ledgerRepository Repository
+}
+
+func NewService(ledgerRepository Repository) Service {
+	return &service{ledgerRepository: ledgerRepository}
+}
+
+// NewJournalID returns a random identifier used to group the entries of one journal
+func NewJournalID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// GetLedger retrieves the ledger entries of a wallet in chronological order.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The wallet whose entries are returned.
+//   - request: Optional filters, including:
+//   - AssetName: A list of asset names to filter by.
+//   - Start: Only entries created at or after this time.
+//   - End: Only entries created at or before this time.
+//
+// Returns:
+//   - The matching ledger entries ordered by creation.
+//   - An error if the repository query fails.
+func (s *service) GetLedger(ctx context.Context, walletID uint,
+	request *request.GetLedgerParams) ([]*entity.Entry, error) {
+	names := make([]string, 0, len(request.AssetName))
+	for _, name := range request.AssetName {
+		names = append(names, catalog.NormalizeSymbol(name))
+	}
+
+	filters := entity.Filters{
+		WalletID:     []uint{walletID},
+		AssetName:    names,
+		CreatedStart: request.Start,
+		CreatedEnd:   request.End,
+	}
+	return s.ledgerRepository.GetEntries(ctx, filters)
+}
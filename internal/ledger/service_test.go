@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/asset-management-service/internal/ledger/mock"
+	"github.com/safayildirim/asset-management-service/internal/ledger/request"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestService_GetLedger(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		walletID       uint
+		request        *request.GetLedgerParams
+		mockFilters    entity.Filters
+		mockReturn     []*entity.Entry
+		mockError      error
+		expectedResult []*entity.Entry
+		expectedError  error
+	}{
+		{
+			name:     "when time range and asset are provided then should filter entries",
+			walletID: 1,
+			request:  &request.GetLedgerParams{AssetName: []string{"btc"}, Start: start, End: end},
+			mockFilters: entity.Filters{
+				WalletID:     []uint{1},
+				AssetName:    []string{"BTC"},
+				CreatedStart: start,
+				CreatedEnd:   end,
+			},
+			mockReturn: []*entity.Entry{
+				{ID: 1, AssetName: "BTC", Direction: entity.Credit, Amount: decimal.NewFromInt(10)},
+			},
+			expectedResult: []*entity.Entry{
+				{ID: 1, AssetName: "BTC", Direction: entity.Credit, Amount: decimal.NewFromInt(10)},
+			},
+		},
+		{
+			name:           "when no filters are provided then should return every entry of the wallet",
+			walletID:       2,
+			request:        &request.GetLedgerParams{},
+			mockFilters:    entity.Filters{WalletID: []uint{2}, AssetName: []string{}},
+			mockReturn:     []*entity.Entry{},
+			expectedResult: []*entity.Entry{},
+		},
+		{
+			name:          "when repository returns error then should return error",
+			walletID:      3,
+			request:       &request.GetLedgerParams{},
+			mockFilters:   entity.Filters{WalletID: []uint{3}, AssetName: []string{}},
+			mockError:     errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetEntries(context.Background(), tt.mockFilters).
+				Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.GetLedger(context.Background(), tt.walletID, tt.request)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNewJournal(t *testing.T) {
+	reference := entity.Reference{Type: entity.ReferenceScheduledTransaction, ID: "42"}
+
+	entries := entity.NewJournal("journal", 7, "BTC", entity.Debit, decimal.RequireFromString("1.5"),
+		decimal.RequireFromString("0.5"), reference)
+
+	assert.Len(t, entries, 2)
+
+	// The wallet entry carries the balance after the change
+	assert.Equal(t, int64(7), entries[0].WalletID.Int64)
+	assert.Equal(t, entity.Debit, entries[0].Direction)
+	assert.True(t, entries[0].BalanceAfter.Valid)
+	assert.Equal(t, "0.5", entries[0].BalanceAfter.Decimal.String())
+
+	// The counterparty entry balances the journal on the external account
+	assert.False(t, entries[1].WalletID.Valid)
+	assert.Equal(t, entity.Credit, entries[1].Direction)
+	assert.False(t, entries[1].BalanceAfter.Valid)
+
+	for _, e := range entries {
+		assert.Equal(t, "journal", e.JournalID)
+		assert.Equal(t, "1.5", e.Amount.String())
+		assert.Equal(t, entity.ReferenceScheduledTransaction, e.ReferenceType)
+		assert.Equal(t, "42", e.ReferenceID.String)
+	}
+}
@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+	"strings"
+	"time"
+)
+
+// Event is a domain event waiting in the outbox to be published. Events are written in the same database transaction
+// as the state change they describe, so that an event exists if and only if the change was committed, and are
+// published by the relay afterwards.
+type Event struct {
+	ID            uint          `json:"id"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Type          EventType     `json:"type"`
+	AggregateType AggregateType `json:"aggregate_type"`
+	AggregateID   uint          `json:"aggregate_id"`
+	Payload       Payload       `json:"payload"`
+	// Attempts counts the failed attempts to publish the event
+	Attempts       int         `json:"-"`
+	LastError      null.String `json:"-"`
+	NextAttemptAt  null.Time   `json:"-"`
+	PublishedAt    null.Time   `json:"-"`
+	ClaimedBy      null.String `json:"-"`
+	ClaimExpiresAt null.Time   `json:"-"`
+}
+
+func (Event) TableName() string {
+	return "outbox_events"
+}
+
+type EventType string
+
+const (
+	AssetCreated         EventType = "asset.created"
+	Deposited            EventType = "asset.deposited"
+	Withdrawn            EventType = "asset.withdrawn"
+	TransactionScheduled EventType = "transaction.scheduled"
+	TransactionCompleted EventType = "transaction.completed"
+	TransactionFailed    EventType = "transaction.failed"
+	TransactionCancelled EventType = "transaction.cancelled"
+)
+
+// EventTypes lists every type of event raised by the service
+var EventTypes = []EventType{AssetCreated, Deposited, Withdrawn, TransactionScheduled, TransactionCompleted,
+	TransactionFailed, TransactionCancelled}
+
+// Aggregate returns the type of the aggregate events of this type are about, i.e. the part of the type before the dot
+func (t EventType) Aggregate() AggregateType {
+	aggregate, _, _ := strings.Cut(string(t), ".")
+	return AggregateType(aggregate)
+}
+
+type AggregateType string
+
+const (
+	AggregateAsset       AggregateType = "asset"
+	AggregateTransaction AggregateType = "transaction"
+)
+
+// AssetPayload describes an asset of a wallet and the change of its balance that raised the event
+type AssetPayload struct {
+	AssetID  uint   `json:"asset_id"`
+	WalletID uint   `json:"wallet_id"`
+	Asset    string `json:"asset"`
+	// Amount is the amount the balance changed by, or the initial balance of a created asset
+	Amount        decimal.Decimal `json:"amount"`
+	Balance       decimal.Decimal `json:"balance"`
+	Held          decimal.Decimal `json:"held"`
+	ReferenceType string          `json:"reference_type,omitempty"`
+	ReferenceID   string          `json:"reference_id,omitempty"`
+}
+
+// NewEvent builds an event of the given type about the aggregate with the given ID, encoding the payload as JSON
+func NewEvent(eventType EventType, aggregateID uint, payload any) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payload of %s event: %w", eventType, err)
+	}
+
+	return &Event{
+		Type:          eventType,
+		AggregateType: eventType.Aggregate(),
+		AggregateID:   aggregateID,
+		Payload:       data,
+	}, nil
+}
+
+// Payload is the JSON document describing an event, stored in a jsonb column and embedded as is when the event is
+// encoded as JSON
+type Payload []byte
+
+// Value stores the payload as text, which the jsonb column accepts
+func (p Payload) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return string(p), nil
+}
+
+// Scan reads the payload from a jsonb column
+func (p *Payload) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*p = nil
+	case []byte:
+		*p = append(Payload(nil), v...)
+	case string:
+		*p = Payload(v)
+	default:
+		return fmt.Errorf("cannot scan %T into event payload", value)
+	}
+
+	return nil
+}
+
+func (p Payload) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+
+	return p, nil
+}
+
+func (p *Payload) UnmarshalJSON(data []byte) error {
+	*p = append(Payload(nil), data...)
+	return nil
+}
+
+// WalletIDs returns the wallets the event is about: the wallet of an asset event, or the source and destination
+// wallets of a transaction event
+func (e *Event) WalletIDs() ([]uint, error) {
+	var payload struct {
+		WalletID            uint `json:"wallet_id"`
+		SourceWalletID      uint `json:"source_wallet_id"`
+		DestinationWalletID uint `json:"destination_wallet_id"`
+	}
+
+	err := json.Unmarshal(e.Payload, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode payload of event %d: %w", e.ID, err)
+	}
+
+	var walletIDs []uint
+	for _, id := range []uint{payload.WalletID, payload.SourceWalletID, payload.DestinationWalletID} {
+		if id != 0 {
+			walletIDs = append(walletIDs, id)
+		}
+	}
+
+	return walletIDs, nil
+}
@@ -0,0 +1,8 @@
+package outbox
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrClaimLost is internal to the relay and never reaches a client
+	ErrClaimLost = errors.New("event is no longer claimed by this relay")
+)
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Publisher delivers outbox events to their consumers, e.g. a message broker. Delivery is at least once: an event is
+// published again if the relay fails to record that it was published, so consumers must tolerate duplicates, which
+// they can recognise by the event ID.
+type Publisher interface {
+	Publish(ctx context.Context, event *entity.Event) error
+}
+
+// LogPublisher publishes events by writing them to the log, for environments without any consumer
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(_ context.Context, event *entity.Event) error {
+	log.Logger.Info("event published", zap.Uint("id", event.ID), zap.String("type", string(event.Type)),
+		zap.Uint("aggregate_id", event.AggregateID), zap.ByteString("payload", event.Payload))
+
+	return nil
+}
+
+// MultiPublisher publishes every event to each of its publishers in turn. An event that fails with one of them is
+// published to all of them again, which they tolerate like any other duplicate.
+type MultiPublisher []Publisher
+
+func (m MultiPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	for _, publisher := range m {
+		err := publisher.Publish(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Record writes an event of the given type about the aggregate with the given ID to the outbox as part of tx, so that
+// it is published if and only if tx commits
+func Record(ctx context.Context, repository Repository, tx *gorm.DB, eventType entity.EventType, aggregateID uint,
+	payload any) error {
+	event, err := entity.NewEvent(eventType, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+
+	return repository.CreateEvents(ctx, tx, []*entity.Event{event})
+}
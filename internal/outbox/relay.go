@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/worker"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Relay publishes the events written to the outbox. Events are leased to the relay before being published, so several
+// instances can run concurrently, and an event is retried with exponential backoff until it was published.
+type Relay struct {
+	id         string
+	cfg        config.OutboxConfig
+	repository Repository
+	publisher  Publisher
+	backoff    worker.Backoff
+}
+
+// NewRelay initializes a new Relay instance publishing the events of the repository with the given publisher
+func NewRelay(cfg config.OutboxConfig, repository Repository, publisher Publisher) *Relay {
+	return &Relay{id: worker.NewInstanceID(), cfg: cfg, repository: repository, publisher: publisher,
+		backoff: worker.NewBackoff(time.Duration(cfg.RetryBaseDelay)*time.Second,
+			time.Duration(cfg.RetryMaxDelay)*time.Second, 0)}
+}
+
+// Start runs the relay in a loop until the context is cancelled, logging errors and keeping running so that a
+// temporary database outage does not stop it
+func (r *Relay) Start(ctx context.Context) {
+	log.Logger.Info("outbox relay started", zap.String("id", r.id))
+
+	ticker := time.NewTicker(time.Duration(r.cfg.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		_, err := r.RunOnce(ctx)
+		if err != nil {
+			log.Logger.Error("failed to run outbox relay", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Logger.Info("outbox relay stopped", zap.String("id", r.id))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims a batch of due events and publishes them in the order they were written.
+//
+// Returns:
+// - The number of events published in this run.
+// - An error if the due events could not be claimed.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	events, err := r.repository.ClaimDueEvents(ctx, r.id, common.Now(),
+		time.Duration(r.cfg.LeaseDuration)*time.Second, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, event := range events {
+		err = r.publish(ctx, event)
+		switch {
+		case err == nil:
+			published++
+		case errors.Is(err, ErrClaimLost):
+			// Another instance claimed the event after the lease expired, so it is published again
+			log.Logger.Warn("outbox event claim lost", zap.Uint("id", event.ID))
+		default:
+			log.Logger.Error("failed to publish outbox event", zap.Uint("id", event.ID),
+				zap.Int("attempts", event.Attempts), zap.Error(err))
+		}
+	}
+
+	return published, nil
+}
+
+// publish hands a claimed event to the publisher and records the outcome. A failed event is scheduled for another
+// attempt; the publishing error is returned once that is recorded.
+func (r *Relay) publish(ctx context.Context, event *entity.Event) error {
+	publishErr := r.publisher.Publish(ctx, event)
+
+	now := common.Now()
+	if publishErr == nil {
+		event.PublishedAt = null.TimeFrom(now)
+		event.NextAttemptAt = null.Time{}
+	} else {
+		event.Attempts++
+		event.LastError = null.StringFrom(publishErr.Error())
+		event.NextAttemptAt = null.TimeFrom(now.Add(r.backoff.NextDelay(event.Attempts)))
+	}
+
+	err := r.repository.UpdateClaimedEvent(ctx, event, r.id, now)
+	if err != nil {
+		return err
+	}
+
+	return publishErr
+}
@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	outboxmock "github.com/safayildirim/asset-management-service/internal/outbox/mock"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+	"time"
+)
+
+func TestRelay_RunOnce(t *testing.T) {
+	cfg := config.OutboxConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10, RetryBaseDelay: 5, RetryMaxDelay: 60}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		events            []*entity.Event
+		mockClaimErr      error
+		mockPublishErrs   map[uint]error
+		mockUpdateErrs    map[uint]error
+		expectedPublished int
+		expectedError     error
+		expectedEvents    []*entity.Event
+	}{
+		{
+			name:              "when events are due then should publish them in order and mark them published",
+			events:            []*entity.Event{{ID: 1, Type: entity.Deposited}, {ID: 2, Type: entity.Withdrawn}},
+			expectedPublished: 2,
+			expectedEvents: []*entity.Event{
+				{ID: 1, Type: entity.Deposited, PublishedAt: null.TimeFrom(now)},
+				{ID: 2, Type: entity.Withdrawn, PublishedAt: null.TimeFrom(now)},
+			},
+		},
+		{
+			name:              "when publishing fails then should schedule another attempt with backoff",
+			events:            []*entity.Event{{ID: 1, Attempts: 2}, {ID: 2}},
+			mockPublishErrs:   map[uint]error{1: errors.New("broker unavailable")},
+			expectedPublished: 1,
+			expectedEvents: []*entity.Event{
+				{ID: 1, Attempts: 3, LastError: null.StringFrom("broker unavailable"),
+					NextAttemptAt: null.TimeFrom(now.Add(20 * time.Second))},
+				{ID: 2, PublishedAt: null.TimeFrom(now)},
+			},
+		},
+		{
+			name:              "when the backoff exceeds the maximum delay then should cap it",
+			events:            []*entity.Event{{ID: 1, Attempts: 9}},
+			mockPublishErrs:   map[uint]error{1: errors.New("broker unavailable")},
+			expectedPublished: 0,
+			expectedEvents: []*entity.Event{
+				{ID: 1, Attempts: 10, LastError: null.StringFrom("broker unavailable"),
+					NextAttemptAt: null.TimeFrom(now.Add(time.Minute))},
+			},
+		},
+		{
+			name:              "when the claim of an event was lost then should not count it as published",
+			events:            []*entity.Event{{ID: 1}, {ID: 2}},
+			mockUpdateErrs:    map[uint]error{1: ErrClaimLost},
+			expectedPublished: 1,
+			expectedEvents: []*entity.Event{
+				{ID: 1, PublishedAt: null.TimeFrom(now)},
+				{ID: 2, PublishedAt: null.TimeFrom(now)},
+			},
+		},
+		{
+			name:          "when due events cannot be claimed then should return error",
+			mockClaimErr:  errors.New("connection refused"),
+			expectedError: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			mockRepository := outboxmock.NewMockOutboxRepository(t)
+			mockPublisher := outboxmock.NewMockOutboxPublisher(t)
+			relay := NewRelay(cfg, mockRepository, mockPublisher)
+
+			mockRepository.EXPECT().ClaimDueEvents(mock.Anything, relay.id, now, time.Minute, 10).
+				Return(tt.events, tt.mockClaimErr).Once()
+
+			var published []uint
+			for _, event := range tt.events {
+				mockPublisher.EXPECT().Publish(mock.Anything, event).
+					RunAndReturn(func(_ context.Context, event *entity.Event) error {
+						published = append(published, event.ID)
+						return tt.mockPublishErrs[event.ID]
+					}).Once()
+				mockRepository.EXPECT().UpdateClaimedEvent(mock.Anything, event, relay.id, now).
+					Return(tt.mockUpdateErrs[event.ID]).Once()
+			}
+
+			count, err := relay.RunOnce(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPublished, count)
+			assert.Equal(t, tt.expectedEvents, tt.events)
+			for i, event := range tt.events {
+				assert.Equal(t, event.ID, published[i])
+			}
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	event, err := entity.NewEvent(entity.TransactionCompleted, 7, map[string]any{"id": 7, "status": "completed"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.AggregateTransaction, event.AggregateType)
+	assert.Equal(t, uint(7), event.AggregateID)
+	assert.JSONEq(t, `{"id":7,"status":"completed"}`, string(event.Payload))
+}
@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+type Repository interface {
+	CreateEvents(ctx context.Context, tx *gorm.DB, events []*entity.Event) error
+	ClaimDueEvents(ctx context.Context, owner string, now time.Time, lease time.Duration,
+		limit int) ([]*entity.Event, error)
+	UpdateClaimedEvent(ctx context.Context, event *entity.Event, owner string, now time.Time) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// CreateEvents appends events to the outbox; the caller is expected to pass the transaction that also applies the
+// state change the events describe
+func (r *repository) CreateEvents(ctx context.Context, tx *gorm.DB, events []*entity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	err := db.WithContext(ctx).Create(events).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDueEvents leases up to limit unpublished events to the given owner, in the order they were written. Events
+// waiting for another publishing attempt are only due once their next attempt time has been reached.
+//
+// Like the claims of the scheduler, events whose lease expired are claimed again and rows locked by a concurrent claim
+// are skipped, so any number of relay instances can run at the same time.
+func (r *repository) ClaimDueEvents(ctx context.Context, owner string, now time.Time, lease time.Duration,
+	limit int) ([]*entity.Event, error) {
+	var events []*entity.Event
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbox_events
+		SET claimed_by = ?, claim_expires_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE published_at IS NULL AND (claim_expires_at IS NULL OR claim_expires_at <= ?)
+				AND (next_attempt_at IS NULL OR next_attempt_at <= ?)
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		owner, now.Add(lease), now, now, limit).Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+
+	return events, nil
+}
+
+// UpdateClaimedEvent saves the publishing state of a claimed event and releases its claim, provided the given owner
+// still holds an unexpired lease on it. ErrClaimLost is returned otherwise.
+func (r *repository) UpdateClaimedEvent(ctx context.Context, event *entity.Event, owner string,
+	now time.Time) error {
+	event.ClaimedBy = null.String{}
+	event.ClaimExpiresAt = null.Time{}
+
+	result := r.db.WithContext(ctx).Model(event).Where("claimed_by = ? AND claim_expires_at > ?", owner, now).
+		Select("attempts", "last_error", "next_attempt_at", "published_at", "claimed_by", "claim_expires_at").
+		Updates(event)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrClaimLost
+	}
+
+	return nil
+}
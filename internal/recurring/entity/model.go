@@ -0,0 +1,9 @@
+package entity
+
+type Filters struct {
+	ID                  []uint
+	SourceWalletID      []uint
+	DestinationWalletID []uint
+	Status              []string
+	ForUpdate           bool
+}
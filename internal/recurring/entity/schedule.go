@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// RecurringSchedule is a standing order that transfers a fixed amount between two wallets on every occurrence of a
+// cron expression or a simple interval. Each occurrence is materialised as its own scheduled transaction.
+type RecurringSchedule struct {
+	ID                  uint            `json:"id"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           null.Time       `json:"updated_at"`
+	SourceWalletID      uint            `json:"source_wallet_id"`
+	DestinationWalletID uint            `json:"destination_wallet_id"`
+	AssetName           string          `json:"asset_name"`
+	Amount              decimal.Decimal `json:"amount"`
+	CronExpression      null.String     `json:"cron_expression"`
+	Interval            null.String     `json:"interval"`
+	StartAt             time.Time       `json:"start_at"`
+	EndAt               null.Time       `json:"end_at"`
+	MaxOccurrences      null.Int        `json:"max_occurrences"`
+	Occurrences         int             `json:"occurrences"`
+	NextRunAt           null.Time       `json:"next_run_at"`
+	Status              ScheduleStatus  `json:"status"`
+}
+
+func (RecurringSchedule) TableName() string {
+	return "recurring_schedules"
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleActive    ScheduleStatus = "active"
+	SchedulePaused    ScheduleStatus = "paused"
+	ScheduleCancelled ScheduleStatus = "cancelled"
+	ScheduleCompleted ScheduleStatus = "completed"
+)
+
+type Interval string
+
+const (
+	IntervalDaily   Interval = "daily"
+	IntervalWeekly  Interval = "weekly"
+	IntervalMonthly Interval = "monthly"
+)
@@ -0,0 +1,20 @@
+package recurring
+
+import (
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrScheduleNotFound = apperror.New(apperror.CodeScheduleNotFound, http.StatusNotFound,
+		"recurring schedule not found")
+	ErrScheduleNotActive = apperror.New(apperror.CodeScheduleNotActive, http.StatusConflict,
+		"recurring schedule is not active")
+	ErrScheduleNotPaused = apperror.New(apperror.CodeScheduleNotPaused, http.StatusConflict,
+		"recurring schedule is not paused")
+	ErrScheduleFinished = apperror.New(apperror.CodeScheduleFinished, http.StatusConflict,
+		"recurring schedule is already cancelled or completed")
+	ErrScheduleExhausted = apperror.New(apperror.CodeScheduleExhausted, http.StatusBadRequest,
+		"recurring schedule has no occurrence left")
+	ErrAssetNotFound = apperror.New(apperror.CodeAssetNotFound, http.StatusNotFound, "asset not found")
+)
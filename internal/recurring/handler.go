@@ -0,0 +1,112 @@
+package recurring
+
+import (
+	"context"
+	"github.com/gorilla/schema"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	"github.com/safayildirim/asset-management-service/internal/recurring/request"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+var decoder = schema.NewDecoder()
+
+func init() {
+	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
+		return reflect.ValueOf(strings.Split(value, ","))
+	})
+}
+
+type Handler struct {
+	recurringService Service
+}
+
+// NewHandler initializes a new Handler instance with the provided recurring schedule service
+func NewHandler(recurringService Service) *Handler {
+	return &Handler{recurringService: recurringService}
+}
+
+// RegisterRoutes registers the recurring schedule API routes with the provided Echo router group
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/recurring-schedules", h.CreateSchedule)
+	e.GET("/recurring-schedules", h.GetSchedules)
+	e.POST("/recurring-schedules/:id/pause", h.PauseSchedule)
+	e.POST("/recurring-schedules/:id/resume", h.ResumeSchedule)
+	e.DELETE("/recurring-schedules/:id", h.CancelSchedule)
+}
+
+// CreateSchedule handles requests to create a recurring schedule
+func (h Handler) CreateSchedule(ctx echo.Context) error {
+	var req request.CreateScheduleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	schedule, err := h.recurringService.CreateSchedule(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, common.Response{Data: schedule})
+}
+
+// GetSchedules handles requests to list recurring schedules
+func (h Handler) GetSchedules(ctx echo.Context) error {
+	var req request.GetSchedulesParams
+	params := ctx.QueryParams()
+
+	err := decoder.Decode(&req, params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	schedules, err := h.recurringService.GetSchedules(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: schedules})
+}
+
+// PauseSchedule handles requests to pause an active recurring schedule
+func (h Handler) PauseSchedule(ctx echo.Context) error {
+	return h.changeSchedule(ctx, h.recurringService.PauseSchedule)
+}
+
+// ResumeSchedule handles requests to resume a paused recurring schedule
+func (h Handler) ResumeSchedule(ctx echo.Context) error {
+	return h.changeSchedule(ctx, h.recurringService.ResumeSchedule)
+}
+
+// CancelSchedule handles requests to cancel a recurring schedule
+func (h Handler) CancelSchedule(ctx echo.Context) error {
+	return h.changeSchedule(ctx, h.recurringService.CancelSchedule)
+}
+
+// changeSchedule applies a state change to the schedule identified by the id path parameter
+func (h Handler) changeSchedule(ctx echo.Context,
+	change func(ctx context.Context, id uint) (*entity.RecurringSchedule, error)) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	schedule, err := change(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: schedule})
+}
@@ -0,0 +1,212 @@
+package recurring
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	recurringmock "github.com/safayildirim/asset-management-service/internal/recurring/mock"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_CreateSchedule(t *testing.T) {
+	e := echo.New()
+
+	common.Now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { common.Now = time.Now }()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturnErr        error
+		expectErr            bool
+		expectedStatus       int
+		expectedErrorMessage string
+	}{
+		{
+			name: "when interval schedule is valid then should return created",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"interval":"monthly","start_at":"2024-01-31T09:00:00Z","max_occurrences":12}`,
+			mockService:    true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "when neither cron expression nor interval is set then should return bad request",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"start_at":"2024-01-31T09:00:00Z"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "either cron_expression or interval is required",
+		},
+		{
+			name: "when both cron expression and interval are set then should return bad request",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"cron_expression":"0 9 * * *","interval":"daily","start_at":"2024-01-31T09:00:00Z"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "must not be set together with interval",
+		},
+		{
+			name: "when cron expression is invalid then should return bad request",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"cron_expression":"every day","start_at":"2024-01-31T09:00:00Z"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "must be a valid cron expression",
+		},
+		{
+			name: "when end date is before start then should return bad request",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"interval":"daily","start_at":"2024-01-31T09:00:00Z","end_at":"2024-01-01T00:00:00Z"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "must be after start_at",
+		},
+		{
+			name: "when start date is in the past then should return bad request",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"interval":"daily","start_at":"2023-12-31T09:00:00Z"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "must not be in the past",
+		},
+		{
+			name: "when asset is missing on a wallet then should return not found",
+			body: `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1",
+				"interval":"daily","start_at":"2024-01-31T09:00:00Z"}`,
+			mockService:          true,
+			mockReturnErr:        ErrAssetNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusNotFound,
+			expectedErrorMessage: ErrAssetNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := recurringmock.NewMockRecurringService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				var result *entity.RecurringSchedule
+				if tt.mockReturnErr == nil {
+					result = &entity.RecurringSchedule{ID: 1}
+				}
+				mockService.EXPECT().CreateSchedule(mock.Anything, mock.Anything).Return(result, tt.mockReturnErr).
+					Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/recurring-schedules", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateSchedule(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_PauseSchedule(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		id                   string
+		mockService          bool
+		mockReturnErr        error
+		expectErr            bool
+		expectedStatus       int
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when schedule is active then should return ok",
+			id:             "1",
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when id is invalid then should return bad request",
+			id:                   "invalid",
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when schedule does not exist then should return not found",
+			id:                   "1",
+			mockService:          true,
+			mockReturnErr:        ErrScheduleNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusNotFound,
+			expectedErrorMessage: ErrScheduleNotFound.Error(),
+		},
+		{
+			name:                 "when schedule is not active then should return conflict",
+			id:                   "1",
+			mockService:          true,
+			mockReturnErr:        ErrScheduleNotActive,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: ErrScheduleNotActive.Error(),
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			id:                   "1",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectErr:            true,
+			expectedStatus:       http.StatusInternalServerError,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := recurringmock.NewMockRecurringService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				var result *entity.RecurringSchedule
+				if tt.mockReturnErr == nil {
+					result = &entity.RecurringSchedule{ID: 1, Status: entity.SchedulePaused}
+				}
+				mockService.EXPECT().PauseSchedule(mock.Anything, uint(1)).Return(result, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/recurring-schedules/"+tt.id+"/pause", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/recurring-schedules/:id/pause")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.id)
+
+			err := handler.PauseSchedule(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package recurring
+
+import (
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// ParseCron parses a standard five field cron expression (minute, hour, day of month, month, day of week)
+func ParseCron(expression string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cron expression")
+	}
+
+	return schedule, nil
+}
+
+// NextRun returns the first occurrence of the schedule strictly after the given time.
+//
+// Cron schedules follow their expression. Interval schedules are anchored to StartAt: a monthly schedule starting on
+// the 31st runs on the last day of shorter months instead of drifting into the next month.
+func NextRun(schedule *entity.RecurringSchedule, after time.Time) (time.Time, error) {
+	if schedule.CronExpression.Valid {
+		parsed, err := ParseCron(schedule.CronExpression.String)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		// Expressions that can never match, such as the 30th of February, have no next occurrence
+		next := parsed.Next(after)
+		if next.IsZero() {
+			return time.Time{}, ErrScheduleExhausted
+		}
+
+		return next, nil
+	}
+
+	start, interval := schedule.StartAt, entity.Interval(schedule.Interval.String)
+
+	// Estimate the number of intervals elapsed since the start, then correct the estimate by the few occurrences that
+	// daylight saving changes and months of different lengths can shift it by
+	n := max(elapsedIntervals(start, interval, after), 0)
+	occurrence, err := nthOccurrence(start, interval, n)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for n > 0 && occurrence.After(after) {
+		n--
+		occurrence, _ = nthOccurrence(start, interval, n)
+	}
+
+	for !occurrence.After(after) {
+		n++
+		occurrence, _ = nthOccurrence(start, interval, n)
+	}
+
+	return occurrence, nil
+}
+
+// elapsedIntervals estimates the number of whole intervals between the start of a schedule and the given time
+func elapsedIntervals(start time.Time, interval entity.Interval, after time.Time) int {
+	switch interval {
+	case entity.IntervalDaily:
+		return int(after.Sub(start) / (24 * time.Hour))
+	case entity.IntervalWeekly:
+		return int(after.Sub(start) / (7 * 24 * time.Hour))
+	case entity.IntervalMonthly:
+		return (after.Year()-start.Year())*12 + int(after.Month()) - int(start.Month())
+	default:
+		return 0
+	}
+}
+
+// nthOccurrence returns the occurrence of an interval schedule that lies n intervals after the start
+func nthOccurrence(start time.Time, interval entity.Interval, n int) (time.Time, error) {
+	switch interval {
+	case entity.IntervalDaily:
+		return start.AddDate(0, 0, n), nil
+	case entity.IntervalWeekly:
+		return start.AddDate(0, 0, 7*n), nil
+	case entity.IntervalMonthly:
+		year, month := start.Year(), start.Month()+time.Month(n)
+		lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, start.Location()).Day()
+
+		return time.Date(year, month, min(start.Day(), lastDay), start.Hour(), start.Minute(), start.Second(),
+			start.Nanosecond(), start.Location()), nil
+	default:
+		return time.Time{}, errors.Errorf("unsupported interval %q", interval)
+	}
+}
+
+// advance moves a schedule past its current occurrence, completing it when its end date or occurrence limit is
+// reached
+func advance(schedule *entity.RecurringSchedule) error {
+	if schedule.MaxOccurrences.Valid && int64(schedule.Occurrences) >= schedule.MaxOccurrences.Int64 {
+		complete(schedule)
+		return nil
+	}
+
+	return skipTo(schedule, schedule.NextRunAt.Time)
+}
+
+// skipTo moves a schedule to its first occurrence strictly after the given time without materialising the occurrences
+// in between, completing it when no occurrence is left before its end date
+func skipTo(schedule *entity.RecurringSchedule, after time.Time) error {
+	next, err := NextRun(schedule, after)
+	if errors.Is(err, ErrScheduleExhausted) {
+		complete(schedule)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	schedule.NextRunAt = null.TimeFrom(next)
+	if schedule.EndAt.Valid && next.After(schedule.EndAt.Time) {
+		complete(schedule)
+	}
+
+	return nil
+}
+
+func complete(schedule *entity.RecurringSchedule) {
+	schedule.Status = entity.ScheduleCompleted
+	schedule.NextRunAt = null.Time{}
+}
@@ -0,0 +1,144 @@
+package recurring
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Repository interface {
+	CreateSchedule(ctx context.Context, tx *gorm.DB, item *entity.RecurringSchedule) (*entity.RecurringSchedule,
+		error)
+	GetSchedules(ctx context.Context, tx *gorm.DB, filters entity.Filters) ([]*entity.RecurringSchedule, error)
+	UpdateSchedule(ctx context.Context, tx *gorm.DB, item *entity.RecurringSchedule) error
+	GetDueScheduleIDs(ctx context.Context, now time.Time, limit int) ([]uint, error)
+	LockDueSchedule(ctx context.Context, tx *gorm.DB, id uint, now time.Time) (*entity.RecurringSchedule, error)
+	InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateSchedule(ctx context.Context, tx *gorm.DB,
+	item *entity.RecurringSchedule) (*entity.RecurringSchedule, error) {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	err := db.WithContext(ctx).Create(item).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repository) GetSchedules(ctx context.Context, tx *gorm.DB,
+	filters entity.Filters) ([]*entity.RecurringSchedule, error) {
+	var schedules []*entity.RecurringSchedule
+
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	query := db.WithContext(ctx).Model(&entity.RecurringSchedule{})
+
+	if len(filters.ID) > 0 {
+		query = query.Where("id IN ?", filters.ID)
+	}
+	if len(filters.SourceWalletID) > 0 {
+		query = query.Where("source_wallet_id IN ?", filters.SourceWalletID)
+	}
+	if len(filters.DestinationWalletID) > 0 {
+		query = query.Where("destination_wallet_id IN ?", filters.DestinationWalletID)
+	}
+	if len(filters.Status) > 0 {
+		query = query.Where("status IN ?", filters.Status)
+	}
+	if filters.ForUpdate {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	err := query.Order("id").Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (r *repository) UpdateSchedule(ctx context.Context, tx *gorm.DB, item *entity.RecurringSchedule) error {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	err := db.WithContext(ctx).Save(item).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetDueScheduleIDs returns the IDs of up to limit active schedules whose next run is due at the given time, the
+// longest overdue first. The schedules are not locked; see LockDueSchedule.
+func (r *repository) GetDueScheduleIDs(ctx context.Context, now time.Time, limit int) ([]uint, error) {
+	var ids []uint
+
+	err := r.db.WithContext(ctx).
+		Model(&entity.RecurringSchedule{}).
+		Where("status = ? AND next_run_at <= ?", entity.ScheduleActive, now).
+		Order("next_run_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// LockDueSchedule locks the schedule with the given ID if it is still active and due at the given time. It must run
+// inside a database transaction; nil is returned if the schedule is no longer due or is locked by a concurrent
+// scheduler instance, which is skipped rather than waited on.
+func (r *repository) LockDueSchedule(ctx context.Context, tx *gorm.DB, id uint,
+	now time.Time) (*entity.RecurringSchedule, error) {
+	var schedules []*entity.RecurringSchedule
+
+	err := tx.WithContext(ctx).
+		Where("id = ? AND status = ? AND next_run_at <= ?", id, entity.ScheduleActive, now).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+
+	return schedules[0], nil
+}
+
+func (r *repository) InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	tx := r.db.WithContext(ctx).Begin() // Start a transaction
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	// Execute the transactional logic
+	if err := fn(tx); err != nil {
+		tx.Rollback() // Rollback on error
+		return err
+	}
+
+	// Commit if everything is successful
+	return tx.Commit().Error
+}
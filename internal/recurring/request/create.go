@@ -0,0 +1,73 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type CreateScheduleRequest struct {
+	SourceWalletID      uint            `json:"source_wallet_id"`
+	DestinationWalletID uint            `json:"destination_wallet_id"`
+	AssetName           string          `json:"asset_name"`
+	Amount              decimal.Decimal `json:"amount"`
+	CronExpression      string          `json:"cron_expression"`
+	Interval            string          `json:"interval"`
+	StartAt             time.Time       `json:"start_at"`
+	EndAt               *time.Time      `json:"end_at"`
+	MaxOccurrences      *int            `json:"max_occurrences"`
+}
+
+func (r CreateScheduleRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.SourceWalletID, validation.Required),
+		validation.Field(&r.DestinationWalletID, validation.Required),
+		validation.Field(&r.AssetName, validation.Required),
+		validation.Field(&r.Amount, common.PositiveAmount),
+		validation.Field(&r.CronExpression, validation.By(func(value interface{}) error {
+			if r.CronExpression == "" && r.Interval == "" {
+				return errors.New("either cron_expression or interval is required")
+			}
+			if r.CronExpression != "" && r.Interval != "" {
+				return errors.New("must not be set together with interval")
+			}
+			return nil
+		}), validation.By(validCron)),
+		validation.Field(&r.Interval, validation.In(string(entity.IntervalDaily), string(entity.IntervalWeekly),
+			string(entity.IntervalMonthly))),
+		validation.Field(&r.StartAt, validation.Required, validation.By(func(value interface{}) error {
+			if r.StartAt.Before(common.Now()) {
+				return errors.New("must not be in the past")
+			}
+			return nil
+		})),
+		validation.Field(&r.EndAt, validation.By(func(value interface{}) error {
+			if r.EndAt != nil && !r.EndAt.After(r.StartAt) {
+				return errors.New("must be after start_at")
+			}
+			return nil
+		})),
+		validation.Field(&r.MaxOccurrences, validation.Min(1)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "recurring schedule create validation error")
+}
+
+// validCron validates that a value is a standard five field cron expression
+func validCron(value interface{}) error {
+	expression, _ := value.(string)
+	if expression == "" {
+		return nil
+	}
+
+	_, err := cron.ParseStandard(expression)
+	if err != nil {
+		return errors.New("must be a valid cron expression")
+	}
+
+	return nil
+}
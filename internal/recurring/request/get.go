@@ -0,0 +1,23 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+)
+
+type GetSchedulesParams struct {
+	ID                  []uint   `json:"id" schema:"id"`
+	SourceWalletID      []uint   `json:"source_wallet_id" schema:"source_wallet_id"`
+	DestinationWalletID []uint   `json:"destination_wallet_id" schema:"destination_wallet_id"`
+	Status              []string `json:"status" schema:"status"`
+}
+
+func (r GetSchedulesParams) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Status, validation.Each(validation.In(string(entity.ScheduleActive),
+			string(entity.SchedulePaused), string(entity.ScheduleCancelled), string(entity.ScheduleCompleted)))),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "recurring schedule get validation error")
+}
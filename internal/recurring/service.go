@@ -0,0 +1,397 @@
+package recurring
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/asset"
+	assetentity "github.com/safayildirim/asset-management-service/internal/asset/entity"
+	assetrequest "github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	"github.com/safayildirim/asset-management-service/internal/recurring/request"
+	"github.com/safayildirim/asset-management-service/internal/transaction"
+	transactionentity "github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Service interface {
+	CreateSchedule(ctx context.Context, request *request.CreateScheduleRequest) (*entity.RecurringSchedule, error)
+	GetSchedules(ctx context.Context, request *request.GetSchedulesParams) ([]*entity.RecurringSchedule, error)
+	PauseSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error)
+	ResumeSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error)
+	CancelSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error)
+	MaterializeDueOccurrences(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type service struct {
+	cfg                   config.RecurringConfig
+	recurringRepository   Repository
+	transactionRepository transaction.Repository
+	outboxRepository      outbox.Repository
+	assetRepository       asset.Repository
+	assetService          asset.Service
+	catalogService        catalog.Service
+	walletClient          wallet.Client
+	walletRules           *wallet.Rules
+}
+
+func NewService(cfg config.RecurringConfig, recurringRepository Repository,
+	transactionRepository transaction.Repository, outboxRepository outbox.Repository, assetRepository asset.Repository,
+	assetService asset.Service, catalogService catalog.Service, walletClient wallet.Client,
+	walletRules *wallet.Rules) Service {
+	return &service{cfg: cfg, recurringRepository: recurringRepository, transactionRepository: transactionRepository,
+		outboxRepository: outboxRepository, assetRepository: assetRepository, assetService: assetService,
+		catalogService: catalogService, walletClient: walletClient, walletRules: walletRules}
+}
+
+// CreateSchedule creates a recurring schedule that transfers an asset between two wallets on every occurrence.
+//
+// Parameters:
+// - ctx: The context for managing request lifecycle and cancellation.
+// - request: A request object containing details for the recurring schedule, including:
+//   - SourceWalletID: The ID of the wallet sending the asset.
+//   - DestinationWalletID: The ID of the wallet receiving the asset.
+//   - AssetName: The name of the asset to be transferred.
+//   - Amount: The amount of the asset to transfer on every occurrence.
+//   - CronExpression or Interval: When the occurrences happen.
+//   - StartAt: The time from which occurrences are scheduled.
+//   - EndAt, MaxOccurrences: Optional limits after which the schedule completes.
+//
+// Returns:
+//   - A pointer to the newly created schedule, with the time of its first occurrence.
+//   - An error if any validation or persistence step fails.
+//
+// Errors:
+//   - catalog.ErrUnknownAsset, catalog.ErrAssetDisabled: If the asset is not an enabled catalogue asset.
+//   - catalog.ErrAmountPrecision, catalog.ErrAmountBelowMinimum: If the amount does not fit the asset's precision.
+//   - wallet.ErrWalletDeleted, wallet.ErrNetworkMismatch, wallet.ErrAssetNotSupported: If the wallets cannot
+//     exchange the asset.
+//   - ErrAssetNotFound: If the asset is not found for either the source or destination wallet.
+//   - ErrScheduleExhausted: If the schedule has no occurrence at all, or none before its end date.
+//   - Any other error encountered during wallet or asset retrieval, or schedule persistence.
+func (s *service) CreateSchedule(ctx context.Context,
+	request *request.CreateScheduleRequest) (*entity.RecurringSchedule, error) {
+	// Resolve the canonical asset symbol and validate the amount against its precision
+	definition, err := s.catalogService.ValidateAmount(ctx, request.AssetName, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate that both wallets exist by fetching them from the wallet client at once
+	wallets, err := wallet.GetAll(ctx, s.walletClient, request.SourceWalletID, request.DestinationWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that the wallets may exchange the asset
+	err = s.walletRules.CheckTransfer(wallets[request.SourceWalletID], wallets[request.DestinationWalletID],
+		definition.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that assets exist for both wallets; balances are only checked when an occurrence is executed
+	assets, err := s.assetRepository.GetAsset(ctx, nil, assetentity.Filters{
+		Name:     []string{definition.Symbol},
+		WalletID: []uint{request.SourceWalletID, request.DestinationWalletID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(assets) < 2 {
+		return nil, ErrAssetNotFound
+	}
+
+	schedule := &entity.RecurringSchedule{
+		SourceWalletID:      request.SourceWalletID,
+		DestinationWalletID: request.DestinationWalletID,
+		AssetName:           definition.Symbol,
+		Amount:              request.Amount,
+		CronExpression:      null.NewString(request.CronExpression, request.CronExpression != ""),
+		Interval:            null.NewString(request.Interval, request.Interval != ""),
+		StartAt:             request.StartAt,
+		EndAt:               null.TimeFromPtr(request.EndAt),
+		Status:              entity.ScheduleActive,
+	}
+	if request.MaxOccurrences != nil {
+		schedule.MaxOccurrences = null.IntFrom(int64(*request.MaxOccurrences))
+	}
+
+	// The first occurrence is the first one at or after the start time
+	next, err := NextRun(schedule, request.StartAt.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.EndAt.Valid && next.After(schedule.EndAt.Time) {
+		return nil, ErrScheduleExhausted
+	}
+	schedule.NextRunAt = null.TimeFrom(next)
+
+	return s.recurringRepository.CreateSchedule(ctx, nil, schedule)
+}
+
+// GetSchedules retrieves the recurring schedules matching the provided filters.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the filter criteria, including IDs, source and destination wallet IDs and
+//     statuses.
+//
+// Returns:
+//   - A slice of schedules that match the filter criteria.
+//   - An error if the repository query fails.
+func (s *service) GetSchedules(ctx context.Context,
+	request *request.GetSchedulesParams) ([]*entity.RecurringSchedule, error) {
+	return s.recurringRepository.GetSchedules(ctx, nil, entity.Filters{
+		ID:                  request.ID,
+		SourceWalletID:      request.SourceWalletID,
+		DestinationWalletID: request.DestinationWalletID,
+		Status:              request.Status,
+	})
+}
+
+// PauseSchedule stops an active schedule from producing new occurrences. Occurrences that were already materialised
+// as scheduled transactions are not affected.
+//
+// Errors:
+//   - ErrScheduleNotFound: If the schedule with the given ID does not exist.
+//   - ErrScheduleNotActive: If the schedule is not active.
+func (s *service) PauseSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error) {
+	return s.updateSchedule(ctx, id, func(schedule *entity.RecurringSchedule) error {
+		if schedule.Status != entity.ScheduleActive {
+			return ErrScheduleNotActive
+		}
+
+		schedule.Status = entity.SchedulePaused
+		return nil
+	})
+}
+
+// ResumeSchedule reactivates a paused schedule. Occurrences missed while it was paused are skipped; the schedule
+// continues with its first occurrence after the current time, or completes if no occurrence is left.
+//
+// Errors:
+//   - ErrScheduleNotFound: If the schedule with the given ID does not exist.
+//   - ErrScheduleNotPaused: If the schedule is not paused.
+func (s *service) ResumeSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error) {
+	return s.updateSchedule(ctx, id, func(schedule *entity.RecurringSchedule) error {
+		if schedule.Status != entity.SchedulePaused {
+			return ErrScheduleNotPaused
+		}
+
+		schedule.Status = entity.ScheduleActive
+
+		now := common.Now()
+		if schedule.NextRunAt.Valid && !schedule.NextRunAt.Time.Before(now) {
+			return nil
+		}
+
+		return skipTo(schedule, now)
+	})
+}
+
+// CancelSchedule permanently stops a schedule from producing new occurrences. Occurrences that were already
+// materialised as scheduled transactions can be cancelled individually.
+//
+// Errors:
+//   - ErrScheduleNotFound: If the schedule with the given ID does not exist.
+//   - ErrScheduleFinished: If the schedule is already cancelled or completed.
+func (s *service) CancelSchedule(ctx context.Context, id uint) (*entity.RecurringSchedule, error) {
+	return s.updateSchedule(ctx, id, func(schedule *entity.RecurringSchedule) error {
+		if schedule.Status == entity.ScheduleCancelled || schedule.Status == entity.ScheduleCompleted {
+			return ErrScheduleFinished
+		}
+
+		schedule.Status = entity.ScheduleCancelled
+		schedule.NextRunAt = null.Time{}
+		return nil
+	})
+}
+
+// updateSchedule applies a state change to a schedule while holding a row lock, so that it cannot interleave with
+// the materialisation of its occurrences
+func (s *service) updateSchedule(ctx context.Context, id uint,
+	change func(schedule *entity.RecurringSchedule) error) (*entity.RecurringSchedule, error) {
+	var schedule *entity.RecurringSchedule
+
+	err := s.recurringRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		schedules, err := s.recurringRepository.GetSchedules(ctx, tx, entity.Filters{ID: []uint{id}, ForUpdate: true})
+		if err != nil {
+			return err
+		}
+
+		if len(schedules) == 0 {
+			return ErrScheduleNotFound
+		}
+		schedule = schedules[0]
+
+		err = change(schedule)
+		if err != nil {
+			return err
+		}
+
+		schedule.UpdatedAt = null.TimeFrom(common.Now())
+		return s.recurringRepository.UpdateSchedule(ctx, tx, schedule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// MaterializeDueOccurrences creates a pending scheduled transaction, along with its TransactionScheduled event, for
+// every occurrence of an active schedule that is due at the given time, and advances each schedule to its next
+// occurrence. The amount of each occurrence is held on the source wallet in the same database transaction; an
+// occurrence that cannot be held is created as failed, with a TransactionFailed event, and the schedule continues.
+//
+// Occurrences missed while no scheduler was running are materialised, each as its own transaction, as long as they
+// are due within the catch-up window; older occurrences are stale and skipped rather than executed late. At most the
+// configured number of occurrences is materialised per schedule in one call, the rest staying due for the next call.
+// Each schedule is processed in its own database transaction, so a schedule that fails stays due for the next call
+// without holding back the others. Schedules are locked while they are processed, so concurrent scheduler instances
+// never materialise the same occurrence twice.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - now: The time up to which occurrences are due.
+//   - limit: The maximum number of schedules processed in one call.
+//
+// Returns:
+//   - The number of transactions created.
+//   - An error if the due schedules could not be read. Errors of single schedules are logged.
+func (s *service) MaterializeDueOccurrences(ctx context.Context, now time.Time, limit int) (int, error) {
+	ids, err := s.recurringRepository.GetDueScheduleIDs(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, id := range ids {
+		count, err := s.materializeSchedule(ctx, id, now)
+		if err != nil {
+			log.Logger.Error("failed to materialize recurring schedule occurrences", zap.Uint("id", id),
+				zap.Error(err))
+			continue
+		}
+		created += count
+	}
+
+	return created, nil
+}
+
+// materializeSchedule materialises the due occurrences of a single schedule in one database transaction.
+//
+// Returns:
+//   - The number of transactions created, 0 if the schedule is no longer due or is processed by another instance.
+//   - An error if the schedule could not be read or updated, in which case no transaction is created for it.
+func (s *service) materializeSchedule(ctx context.Context, id uint, now time.Time) (int, error) {
+	created := 0
+	staleBefore := now.Add(-time.Duration(s.cfg.CatchUpWindow) * time.Second)
+
+	err := s.recurringRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		created = 0
+
+		schedule, err := s.recurringRepository.LockDueSchedule(ctx, tx, id, now)
+		if err != nil || schedule == nil {
+			return err
+		}
+
+		// Skip the occurrences that are too old to be executed, continuing with the first one in the window
+		if schedule.NextRunAt.Valid && schedule.NextRunAt.Time.Before(staleBefore) {
+			missedAt := schedule.NextRunAt.Time
+
+			err = skipTo(schedule, staleBefore.Add(-time.Nanosecond))
+			if err != nil {
+				return err
+			}
+
+			log.Logger.Warn("skipped stale recurring schedule occurrences", zap.Uint("id", schedule.ID),
+				zap.Time("from", missedAt), zap.Time("to", staleBefore))
+		}
+
+		for materialized := 0; materialized < s.cfg.MaxOccurrencesPerRun &&
+			schedule.Status == entity.ScheduleActive && schedule.NextRunAt.Valid &&
+			!schedule.NextRunAt.Time.After(now); materialized++ {
+			occurrence, err := s.materialize(ctx, tx, schedule)
+			if err != nil {
+				return err
+			}
+			log.Logger.Debug("materialized recurring schedule occurrence", zap.Uint("schedule_id", schedule.ID),
+				zap.Uint("id", occurrence.ID), zap.String("status", string(occurrence.Status)))
+			created++
+			schedule.Occurrences++
+
+			err = advance(schedule)
+			if err != nil {
+				return err
+			}
+		}
+
+		schedule.UpdatedAt = null.TimeFrom(now)
+		return s.recurringRepository.UpdateSchedule(ctx, tx, schedule)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+// materialize creates the transaction of the current occurrence of a schedule along with its event. Like any
+// scheduled transaction, the occurrence holds its amount on the source wallet until it is executed. An occurrence whose
+// amount cannot be held, e.g. because the available balance is insufficient, is created as failed instead; transient
+// errors are returned so that the occurrence is materialised again by the next run.
+func (s *service) materialize(ctx context.Context, tx *gorm.DB,
+	schedule *entity.RecurringSchedule) (*transactionentity.Transaction, error) {
+	occurrence := &transactionentity.Transaction{
+		SourceWalletID:      schedule.SourceWalletID,
+		DestinationWalletID: schedule.DestinationWalletID,
+		AssetName:           schedule.AssetName,
+		Amount:              schedule.Amount,
+		Status:              transactionentity.TransactionPending,
+		ScheduledAt:         schedule.NextRunAt.Time,
+		RecurringScheduleID: null.IntFrom(int64(schedule.ID)),
+		Held:                true,
+	}
+	eventType := outboxentity.TransactionScheduled
+
+	_, err := s.assetService.Hold(ctx, tx, &assetrequest.HoldRequest{
+		WalletID: schedule.SourceWalletID,
+		Name:     schedule.AssetName,
+		Amount:   schedule.Amount,
+	})
+	if err != nil {
+		reason := transaction.ClassifyFailure(err)
+		if reason.Transient() {
+			return nil, err
+		}
+
+		occurrence.Held = false
+		occurrence.Status = transactionentity.TransactionFailed
+		occurrence.FailureReason = null.StringFrom(string(reason))
+		eventType = outboxentity.TransactionFailed
+	}
+
+	occurrence, err = s.transactionRepository.CreateTransaction(ctx, tx, occurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.Record(ctx, s.outboxRepository, tx, eventType, occurrence.ID, occurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	return occurrence, nil
+}
@@ -0,0 +1,696 @@
+package recurring
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset"
+	assetentity "github.com/safayildirim/asset-management-service/internal/asset/entity"
+	assetmock "github.com/safayildirim/asset-management-service/internal/asset/mock"
+	assetrequest "github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	catalogentity "github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	outboxmock "github.com/safayildirim/asset-management-service/internal/outbox/mock"
+	"github.com/safayildirim/asset-management-service/internal/recurring/entity"
+	recurringmock "github.com/safayildirim/asset-management-service/internal/recurring/mock"
+	"github.com/safayildirim/asset-management-service/internal/recurring/request"
+	transactionentity "github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	transactionmock "github.com/safayildirim/asset-management-service/internal/transaction/mock"
+	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func TestNextRun(t *testing.T) {
+	start := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		schedule      *entity.RecurringSchedule
+		after         time.Time
+		expected      time.Time
+		expectedError error
+	}{
+		{
+			name:     "when interval is daily then should return the next day at the start time",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("daily"), StartAt: start},
+			after:    start,
+			expected: time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "when time is before the start then should return the start",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("weekly"), StartAt: start},
+			after:    start.Add(-time.Nanosecond),
+			expected: start,
+		},
+		{
+			name:     "when interval is weekly then should return the next occurrence after the time",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("weekly"), StartAt: start},
+			after:    start.AddDate(0, 0, 10),
+			expected: start.AddDate(0, 0, 14),
+		},
+		{
+			name:     "when monthly start day does not exist in the next month then should use its last day",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("monthly"), StartAt: start},
+			after:    start,
+			expected: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "when monthly schedule passes a short month then should return to the start day",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("monthly"), StartAt: start},
+			after:    time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "when time is years after the start then should return the next daily occurrence",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("daily"), StartAt: start},
+			after:    time.Date(2030, 6, 15, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2030, 6, 16, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "when time is years after a monthly start then should return the next occurrence",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("monthly"), StartAt: start},
+			after:    time.Date(2030, 4, 30, 8, 0, 0, 0, time.UTC),
+			expected: time.Date(2030, 4, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "when daylight saving time changes then should keep the local start time",
+			schedule: &entity.RecurringSchedule{Interval: null.StringFrom("daily"),
+				StartAt: time.Date(2024, 1, 1, 9, 0, 0, 0, amsterdam)},
+			after:    time.Date(2024, 3, 31, 9, 0, 0, 0, amsterdam),
+			expected: time.Date(2024, 4, 1, 9, 0, 0, 0, amsterdam),
+		},
+		{
+			name:     "when cron expression is set then should follow the expression",
+			schedule: &entity.RecurringSchedule{CronExpression: null.StringFrom("30 8 * * 1"), StartAt: start},
+			after:    start,
+			expected: time.Date(2024, 2, 5, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name:          "when cron expression can never match then should return exhausted error",
+			schedule:      &entity.RecurringSchedule{CronExpression: null.StringFrom("0 0 30 2 *"), StartAt: start},
+			after:         start,
+			expectedError: ErrScheduleExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NextRun(tt.schedule, tt.after)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_CreateSchedule(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	endBeforeFirstRun := time.Date(2024, 1, 1, 9, 10, 0, 0, time.UTC)
+	maxOccurrences := 3
+
+	tests := []struct {
+		name            string
+		request         *request.CreateScheduleRequest
+		mockCatalogErr  error
+		mockNetwork     string
+		mockAssets      []*assetentity.Asset
+		mockCreate      bool
+		expectedNextRun time.Time
+		expectedError   error
+	}{
+		{
+			name: "when interval schedule is valid then should create it with the start as first run",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "btc", Amount: decimal.RequireFromString("1"),
+				Interval: "daily", StartAt: start, MaxOccurrences: &maxOccurrences,
+			},
+			mockAssets:      []*assetentity.Asset{{WalletID: 1}, {WalletID: 2}},
+			mockCreate:      true,
+			expectedNextRun: start,
+		},
+		{
+			name: "when cron schedule is valid then should create it with the first matching time",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("1"),
+				CronExpression: "0 12 * * *", StartAt: start,
+			},
+			mockAssets:      []*assetentity.Asset{{WalletID: 1}, {WalletID: 2}},
+			mockCreate:      true,
+			expectedNextRun: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "when asset is disabled then should return error",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("1"),
+				Interval: "daily", StartAt: start,
+			},
+			mockCatalogErr: catalog.ErrAssetDisabled,
+			expectedError:  catalog.ErrAssetDisabled,
+		},
+		{
+			name: "when asset is not supported on the network of the wallets then should return error",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("1"),
+				Interval: "daily", StartAt: start,
+			},
+			mockNetwork:   "ethereum",
+			expectedError: walletpkg.ErrAssetNotSupported,
+		},
+		{
+			name: "when destination wallet has no asset then should return asset not found",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("1"),
+				Interval: "daily", StartAt: start,
+			},
+			mockAssets:    []*assetentity.Asset{{WalletID: 1}},
+			expectedError: ErrAssetNotFound,
+		},
+		{
+			name: "when no occurrence falls before the end date then should return exhausted error",
+			request: &request.CreateScheduleRequest{
+				SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("1"),
+				CronExpression: "0 12 * * *", StartAt: start, EndAt: &endBeforeFirstRun,
+			},
+			mockAssets:    []*assetentity.Asset{{WalletID: 1}, {WalletID: 2}},
+			expectedError: ErrScheduleExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+			mockAssetRepository := assetmock.NewMockAssetRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			mockWalletClient := walletmock.NewMockWalletClient(t)
+			s := NewService(config.RecurringConfig{}, mockRecurringRepository,
+				transactionmock.NewMockTransactionRepository(t), nil, mockAssetRepository, nil, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(map[string][]string{"BTC": {"bitcoin"}}))
+
+			network := tt.mockNetwork
+			if network == "" {
+				network = "bitcoin"
+			}
+
+			if tt.mockCatalogErr != nil {
+				mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.AssetName, tt.request.Amount).
+					Return(nil, tt.mockCatalogErr).Once()
+			} else {
+				mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.AssetName, tt.request.Amount).
+					Return(&catalogentity.AssetDefinition{Symbol: "BTC", Enabled: true}, nil).Once()
+				mockWalletClient.EXPECT().GetWallets(mock.Anything, []uint{1, 2}).
+					Return(map[uint]*walletentity.Wallet{
+						1: {ID: 1, Network: network},
+						2: {ID: 2, Network: network},
+					}, nil).Once()
+			}
+
+			if tt.mockAssets != nil {
+				mockAssetRepository.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), assetentity.Filters{
+					Name: []string{"BTC"}, WalletID: []uint{1, 2},
+				}).Return(tt.mockAssets, nil).Once()
+			}
+
+			if tt.mockCreate {
+				mockRecurringRepository.EXPECT().CreateSchedule(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(item *entity.RecurringSchedule) bool {
+						return item.AssetName == "BTC" && item.Status == entity.ScheduleActive &&
+							item.NextRunAt.Time.Equal(tt.expectedNextRun)
+					})).RunAndReturn(func(_ context.Context, _ *gorm.DB,
+					item *entity.RecurringSchedule) (*entity.RecurringSchedule, error) {
+					return item, nil
+				}).Once()
+			}
+
+			result, err := s.CreateSchedule(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedNextRun, result.NextRunAt.Time)
+			}
+		})
+	}
+}
+
+func TestService_ChangeSchedule(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	common.Now = func() time.Time { return now }
+	defer func() { common.Now = time.Now }()
+
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		change          func(s Service) (*entity.RecurringSchedule, error)
+		mockSchedules   []*entity.RecurringSchedule
+		mockUpdate      bool
+		expectedStatus  entity.ScheduleStatus
+		expectedNextRun null.Time
+		expectedError   error
+	}{
+		{
+			name:   "when active schedule is paused then should pause it",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.PauseSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.ScheduleActive, NextRunAt: null.TimeFrom(now.Add(time.Hour))},
+			},
+			mockUpdate:      true,
+			expectedStatus:  entity.SchedulePaused,
+			expectedNextRun: null.TimeFrom(now.Add(time.Hour)),
+		},
+		{
+			name:   "when paused schedule is paused again then should return not active error",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.PauseSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.SchedulePaused},
+			},
+			expectedError: ErrScheduleNotActive,
+		},
+		{
+			name:   "when paused schedule is resumed then should skip occurrences missed while paused",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.ResumeSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.SchedulePaused, Interval: null.StringFrom("daily"), StartAt: start,
+					NextRunAt: null.TimeFrom(time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC))},
+			},
+			mockUpdate:      true,
+			expectedStatus:  entity.ScheduleActive,
+			expectedNextRun: null.TimeFrom(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:   "when schedule ended while paused then should complete it on resume",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.ResumeSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.SchedulePaused, Interval: null.StringFrom("daily"), StartAt: start,
+					EndAt:     null.TimeFrom(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+					NextRunAt: null.TimeFrom(time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC))},
+			},
+			mockUpdate:     true,
+			expectedStatus: entity.ScheduleCompleted,
+		},
+		{
+			name:   "when active schedule is resumed then should return not paused error",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.ResumeSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.ScheduleActive},
+			},
+			expectedError: ErrScheduleNotPaused,
+		},
+		{
+			name:   "when paused schedule is cancelled then should cancel it",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.CancelSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.SchedulePaused, NextRunAt: null.TimeFrom(now)},
+			},
+			mockUpdate:     true,
+			expectedStatus: entity.ScheduleCancelled,
+		},
+		{
+			name:   "when completed schedule is cancelled then should return finished error",
+			change: func(s Service) (*entity.RecurringSchedule, error) { return s.CancelSchedule(context.Background(), 1) },
+			mockSchedules: []*entity.RecurringSchedule{
+				{ID: 1, Status: entity.ScheduleCompleted},
+			},
+			expectedError: ErrScheduleFinished,
+		},
+		{
+			name:          "when schedule does not exist then should return not found error",
+			change:        func(s Service) (*entity.RecurringSchedule, error) { return s.CancelSchedule(context.Background(), 1) },
+			expectedError: ErrScheduleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+			s := NewService(config.RecurringConfig{}, mockRecurringRepository, nil, nil, nil, nil, nil, nil, nil)
+
+			mockRecurringRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockRecurringRepository.EXPECT().GetSchedules(mock.Anything, (*gorm.DB)(nil),
+				entity.Filters{ID: []uint{1}, ForUpdate: true}).Return(tt.mockSchedules, nil).Once()
+
+			if tt.mockUpdate {
+				mockRecurringRepository.EXPECT().UpdateSchedule(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					Return(nil).Once()
+			}
+
+			result, err := tt.change(s)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, result.Status)
+				assert.Equal(t, tt.expectedNextRun, result.NextRunAt)
+			}
+		})
+	}
+}
+
+func TestService_MaterializeDueOccurrences(t *testing.T) {
+	now := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	daily := &entity.RecurringSchedule{
+		ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.RequireFromString("2"),
+		Interval: null.StringFrom("daily"), StartAt: start, NextRunAt: null.TimeFrom(start),
+		Status: entity.ScheduleActive,
+	}
+	limited := &entity.RecurringSchedule{
+		ID: 2, SourceWalletID: 3, DestinationWalletID: 4, AssetName: "ETH", Amount: decimal.RequireFromString("1"),
+		Interval: null.StringFrom("daily"), StartAt: start, NextRunAt: null.TimeFrom(start),
+		MaxOccurrences: null.IntFrom(2), Status: entity.ScheduleActive,
+	}
+
+	mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+	mockTransactionRepository := transactionmock.NewMockTransactionRepository(t)
+	mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+	mockAssetService := assetmock.NewMockAssetService(t)
+	s := NewService(config.RecurringConfig{CatchUpWindow: 7 * 86400, MaxOccurrencesPerRun: 10},
+		mockRecurringRepository, mockTransactionRepository, mockOutboxRepository, nil, mockAssetService, nil, nil,
+		nil)
+
+	mockRecurringRepository.EXPECT().GetDueScheduleIDs(mock.Anything, now, 10).Return([]uint{1, 2}, nil).Once()
+	mockRecurringRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+			return fn(nil)
+		}).Twice()
+	mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(1), now).
+		Return(daily, nil).Once()
+	mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(2), now).
+		Return(limited, nil).Once()
+	mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+		WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("2"),
+	}).Return(&assetentity.Asset{}, nil).Times(3)
+	mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+		WalletID: 3, Name: "ETH", Amount: decimal.RequireFromString("1"),
+	}).Return(&assetentity.Asset{}, nil).Twice()
+
+	var created []*transactionentity.Transaction
+	mockTransactionRepository.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ *gorm.DB,
+			item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+			created = append(created, item)
+			return item, nil
+		}).Times(5)
+	mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+		mock.MatchedBy(func(events []*outboxentity.Event) bool {
+			return len(events) == 1 && events[0].Type == outboxentity.TransactionScheduled
+		})).Return(nil).Times(5)
+	mockRecurringRepository.EXPECT().UpdateSchedule(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+		Return(nil).Twice()
+
+	count, err := s.MaterializeDueOccurrences(context.Background(), now, 10)
+	require.NoError(t, err)
+
+	// Every missed daily occurrence up to now becomes its own pending transaction holding its amount
+	assert.Equal(t, 5, count)
+	require.Len(t, created, 5)
+	for i, day := range []int{1, 2, 3} {
+		assert.Equal(t, time.Date(2024, 1, day, 9, 0, 0, 0, time.UTC), created[i].ScheduledAt)
+		assert.Equal(t, null.IntFrom(1), created[i].RecurringScheduleID)
+		assert.Equal(t, transactionentity.TransactionPending, created[i].Status)
+		assert.True(t, created[i].Held)
+	}
+	assert.Equal(t, 3, daily.Occurrences)
+	assert.Equal(t, time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC), daily.NextRunAt.Time)
+	assert.Equal(t, entity.ScheduleActive, daily.Status)
+
+	// A schedule that reaches its occurrence limit completes
+	assert.Equal(t, null.IntFrom(2), created[4].RecurringScheduleID)
+	assert.Equal(t, 2, limited.Occurrences)
+	assert.Equal(t, entity.ScheduleCompleted, limited.Status)
+	assert.False(t, limited.NextRunAt.Valid)
+}
+
+func TestService_MaterializeDueOccurrences_CatchUp(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		cfg                 config.RecurringConfig
+		expectedScheduledAt []time.Time
+		expectedNextRun     time.Time
+	}{
+		{
+			name: "when occurrences are older than the catch-up window then should skip them",
+			cfg:  config.RecurringConfig{CatchUpWindow: 2 * 86400, MaxOccurrencesPerRun: 10},
+			expectedScheduledAt: []time.Time{
+				time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+			},
+			expectedNextRun: time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "when more occurrences are due than the cap then should leave the rest for the next run",
+			cfg:  config.RecurringConfig{CatchUpWindow: 7 * 86400, MaxOccurrencesPerRun: 2},
+			expectedScheduledAt: []time.Time{
+				time.Date(2024, 2, 24, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 25, 9, 0, 0, 0, time.UTC),
+			},
+			expectedNextRun: time.Date(2024, 2, 26, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := &entity.RecurringSchedule{
+				ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC",
+				Amount: decimal.RequireFromString("2"), Interval: null.StringFrom("daily"), StartAt: start,
+				NextRunAt: null.TimeFrom(start), Status: entity.ScheduleActive,
+			}
+
+			mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+			mockTransactionRepository := transactionmock.NewMockTransactionRepository(t)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			s := NewService(tt.cfg, mockRecurringRepository, mockTransactionRepository, mockOutboxRepository, nil,
+				mockAssetService, nil, nil, nil)
+
+			mockRecurringRepository.EXPECT().GetDueScheduleIDs(mock.Anything, now, 10).Return([]uint{1}, nil).Once()
+			mockRecurringRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(1), now).
+				Return(schedule, nil).Once()
+			mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+				Return(&assetentity.Asset{}, nil).Times(len(tt.expectedScheduledAt))
+
+			var scheduledAt []time.Time
+			mockTransactionRepository.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+				RunAndReturn(func(_ context.Context, _ *gorm.DB,
+					item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+					scheduledAt = append(scheduledAt, item.ScheduledAt)
+					return item, nil
+				}).Times(len(tt.expectedScheduledAt))
+			mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil), mock.Anything).Return(nil).
+				Times(len(tt.expectedScheduledAt))
+			mockRecurringRepository.EXPECT().UpdateSchedule(mock.Anything, (*gorm.DB)(nil), schedule).Return(nil).
+				Once()
+
+			count, err := s.MaterializeDueOccurrences(context.Background(), now, 10)
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedScheduledAt), count)
+			assert.Equal(t, tt.expectedScheduledAt, scheduledAt)
+			assert.Equal(t, len(tt.expectedScheduledAt), schedule.Occurrences)
+			assert.Equal(t, tt.expectedNextRun, schedule.NextRunAt.Time)
+			assert.Equal(t, entity.ScheduleActive, schedule.Status)
+		})
+	}
+}
+
+func TestService_MaterializeDueOccurrences_Hold(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockHoldErr    error
+		expectedEvent  outboxentity.EventType
+		expectedStatus transactionentity.TransactionStatus
+		expectedReason null.String
+		transient      bool
+	}{
+		{
+			name:           "when the amount is held then should create a pending occurrence",
+			expectedEvent:  outboxentity.TransactionScheduled,
+			expectedStatus: transactionentity.TransactionPending,
+		},
+		{
+			name:           "when the balance is insufficient then should create a failed occurrence",
+			mockHoldErr:    asset.ErrInsufficientBalance,
+			expectedEvent:  outboxentity.TransactionFailed,
+			expectedStatus: transactionentity.TransactionFailed,
+			expectedReason: null.StringFrom(string(transactionentity.FailureInsufficientBalance)),
+		},
+		{
+			name:        "when the hold fails transiently then should leave the occurrence due for the next run",
+			mockHoldErr: asset.ErrConcurrentUpdate,
+			transient:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := &entity.RecurringSchedule{
+				ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC",
+				Amount: decimal.RequireFromString("2"), Interval: null.StringFrom("daily"), StartAt: start,
+				NextRunAt: null.TimeFrom(start), Status: entity.ScheduleActive,
+			}
+
+			mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+			mockTransactionRepository := transactionmock.NewMockTransactionRepository(t)
+			mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			s := NewService(config.RecurringConfig{CatchUpWindow: 86400, MaxOccurrencesPerRun: 10},
+				mockRecurringRepository, mockTransactionRepository, mockOutboxRepository, nil, mockAssetService, nil,
+				nil, nil)
+
+			mockRecurringRepository.EXPECT().GetDueScheduleIDs(mock.Anything, now, 10).Return([]uint{1}, nil).Once()
+			mockRecurringRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(1), now).
+				Return(schedule, nil).Once()
+			mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+				WalletID: 1, Name: "BTC", Amount: decimal.RequireFromString("2"),
+			}).Return(nil, tt.mockHoldErr).Once()
+
+			var created *transactionentity.Transaction
+			if !tt.transient {
+				mockTransactionRepository.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB,
+						item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+						created = item
+						return item, nil
+					}).Once()
+				mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == tt.expectedEvent
+					})).Return(nil).Once()
+				mockRecurringRepository.EXPECT().UpdateSchedule(mock.Anything, (*gorm.DB)(nil), schedule).
+					Return(nil).Once()
+			}
+
+			count, err := s.MaterializeDueOccurrences(context.Background(), now, 10)
+			require.NoError(t, err)
+			if tt.transient {
+				assert.Equal(t, 0, count)
+				assert.Equal(t, start, schedule.NextRunAt.Time)
+				return
+			}
+
+			// The schedule moves on whether or not the occurrence could be held
+			assert.Equal(t, 1, count)
+			require.NotNil(t, created)
+			assert.Equal(t, tt.expectedStatus, created.Status)
+			assert.Equal(t, tt.expectedReason, created.FailureReason)
+			assert.Equal(t, tt.expectedStatus == transactionentity.TransactionPending, created.Held)
+			assert.Equal(t, 1, schedule.Occurrences)
+			assert.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), schedule.NextRunAt.Time)
+		})
+	}
+}
+
+func TestService_MaterializeDueOccurrences_PerSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newSchedule := func(id, sourceWalletID uint) *entity.RecurringSchedule {
+		return &entity.RecurringSchedule{
+			ID: id, SourceWalletID: sourceWalletID, DestinationWalletID: 9, AssetName: "BTC",
+			Amount: decimal.RequireFromString("2"), Interval: null.StringFrom("daily"), StartAt: start,
+			NextRunAt: null.TimeFrom(start), Status: entity.ScheduleActive,
+		}
+	}
+	failing := newSchedule(2, 2)
+	succeeding := newSchedule(3, 3)
+
+	mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+	mockTransactionRepository := transactionmock.NewMockTransactionRepository(t)
+	mockOutboxRepository := outboxmock.NewMockOutboxRepository(t)
+	mockAssetService := assetmock.NewMockAssetService(t)
+	s := NewService(config.RecurringConfig{CatchUpWindow: 86400, MaxOccurrencesPerRun: 10},
+		mockRecurringRepository, mockTransactionRepository, mockOutboxRepository, nil, mockAssetService, nil, nil,
+		nil)
+
+	// Every schedule gets its own database transaction
+	mockRecurringRepository.EXPECT().GetDueScheduleIDs(mock.Anything, now, 10).Return([]uint{1, 2, 3}, nil).Once()
+	mockRecurringRepository.EXPECT().InTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+			return fn(nil)
+		}).Times(3)
+
+	// Schedule 1 was processed by another instance in the meantime
+	mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(1), now).
+		Return(nil, nil).Once()
+
+	// Schedule 2 fails transiently, which must not hold back schedule 3
+	mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(2), now).
+		Return(failing, nil).Once()
+	mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil),
+		mock.MatchedBy(func(r *assetrequest.HoldRequest) bool { return r.WalletID == 2 })).Return(nil, errors.New("connection reset")).Once()
+
+	mockRecurringRepository.EXPECT().LockDueSchedule(mock.Anything, (*gorm.DB)(nil), uint(3), now).
+		Return(succeeding, nil).Once()
+	mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil),
+		mock.MatchedBy(func(r *assetrequest.HoldRequest) bool { return r.WalletID == 3 })).Return(&assetentity.Asset{}, nil).Once()
+	mockTransactionRepository.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ *gorm.DB,
+			item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+			return item, nil
+		}).Once()
+	mockOutboxRepository.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil), mock.Anything).Return(nil).Once()
+	mockRecurringRepository.EXPECT().UpdateSchedule(mock.Anything, (*gorm.DB)(nil), succeeding).Return(nil).Once()
+
+	count, err := s.MaterializeDueOccurrences(context.Background(), now, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 0, failing.Occurrences)
+	assert.Equal(t, start, failing.NextRunAt.Time)
+	assert.Equal(t, 1, succeeding.Occurrences)
+	assert.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), succeeding.NextRunAt.Time)
+}
+
+func TestService_MaterializeDueOccurrences_DueSchedulesError(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRecurringRepository := recurringmock.NewMockRecurringRepository(t)
+	s := NewService(config.RecurringConfig{CatchUpWindow: 86400, MaxOccurrencesPerRun: 10},
+		mockRecurringRepository, nil, nil, nil, nil, nil, nil, nil)
+
+	mockRecurringRepository.EXPECT().GetDueScheduleIDs(mock.Anything, now, 10).
+		Return(nil, errors.New("connection refused")).Once()
+
+	count, err := s.MaterializeDueOccurrences(context.Background(), now, 10)
+
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"github.com/lib/pq"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"time"
+)
+
+// Event is a published outbox event as it is streamed to clients. Its sequence orders the events by the time they
+// became visible, which the IDs of the outbox do not: an event with a lower ID may be committed after one with a higher
+// ID. Streamed events are identified by their sequence, so that a client resumes right after the last one it received.
+type Event struct {
+	Sequence      uint                       `json:"-" gorm:"primaryKey"`
+	CreatedAt     time.Time                  `json:"created_at"`
+	EventID       uint                       `json:"id"`
+	Type          outboxentity.EventType     `json:"type"`
+	AggregateType outboxentity.AggregateType `json:"aggregate_type"`
+	AggregateID   uint                       `json:"aggregate_id"`
+	// WalletIDs lists the wallets the event is about, to filter the events of a stream by wallet
+	WalletIDs pq.Int64Array        `json:"-"`
+	Payload   outboxentity.Payload `json:"payload"`
+}
+
+func (Event) TableName() string {
+	return "stream_events"
+}
@@ -0,0 +1,9 @@
+package entity
+
+// Filters selects the events with a sequence after After and up to Until
+type Filters struct {
+	After    uint
+	Until    uint
+	Type     []string
+	WalletID []uint
+}
@@ -0,0 +1,128 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/schema"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	"github.com/safayildirim/asset-management-service/internal/stream/request"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// HeaderLastEventID is sent by reconnecting clients with the ID of the last event they received
+const HeaderLastEventID = "Last-Event-ID"
+
+var decoder = schema.NewDecoder()
+
+func init() {
+	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
+		return reflect.ValueOf(strings.Split(value, ","))
+	})
+}
+
+type Handler struct {
+	streamService Service
+	closed        chan struct{}
+	closeOnce     sync.Once
+}
+
+// NewHandler initializes a new Handler instance with the provided stream service
+func NewHandler(streamService Service) *Handler {
+	return &Handler{streamService: streamService, closed: make(chan struct{})}
+}
+
+// RegisterRoutes registers the event stream API routes with the provided Echo router group
+func (h *Handler) RegisterRoutes(e *echo.Group) {
+	e.GET("/stream", h.Stream)
+}
+
+// Close ends the open streams. Streams never end on their own, so they are closed when the server shuts down rather
+// than holding up the shutdown; clients reconnect to another instance and resume where they left off.
+func (h *Handler) Close() {
+	h.closeOnce.Do(func() { close(h.closed) })
+}
+
+// Stream handles requests to follow balance changes and transaction status transitions as server-sent events
+func (h *Handler) Stream(ctx echo.Context) error {
+	var req request.StreamParams
+	err := decoder.Decode(&req, ctx.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if lastEventID := ctx.Request().Header.Get(HeaderLastEventID); lastEventID != "" {
+		id, err := common.ParseIntFromString[uint](lastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid Last-Event-ID: "+err.Error())
+		}
+		req.LastEventID = &id
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-h.closed:
+			cancel()
+		case <-streamCtx.Done():
+		}
+	}()
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Keep reverse proxies from buffering the events
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	err = h.streamService.Follow(streamCtx, &req, func(events []*entity.Event) error {
+		return writeEvents(res, events)
+	})
+	if err != nil && streamCtx.Err() == nil {
+		// The response has started already, so the client only learns about the error from the closed stream
+		log.Logger.Error("failed to stream events", zap.Error(err))
+	}
+
+	return nil
+}
+
+// writeEvents writes a batch of events to the stream, or a comment keeping the connection alive if there are none
+func writeEvents(res *echo.Response, events []*entity.Event) error {
+	if len(events) == 0 {
+		_, err := fmt.Fprint(res, ": keep-alive\n\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	res.Flush()
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	streammock "github.com/safayildirim/asset-management-service/internal/stream/mock"
+	"github.com/safayildirim/asset-management-service/internal/stream/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler_Stream(t *testing.T) {
+	e := echo.New()
+	createdAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	lastEventID := uint(41)
+
+	tests := []struct {
+		name                 string
+		query                string
+		lastEventID          string
+		mockService          bool
+		mockBatches          [][]*entity.Event
+		expectedRequest      *request.StreamParams
+		expectedBody         string
+		expectedStatus       int
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when events follow the last event then should write them as server-sent events",
+			query:       "?wallet_id=1,2&type=asset.deposited",
+			lastEventID: "41",
+			mockService: true,
+			mockBatches: [][]*entity.Event{
+				{{Sequence: 42, CreatedAt: createdAt, EventID: 7, Type: outboxentity.Deposited,
+					AggregateType: outboxentity.AggregateAsset, AggregateID: 3,
+					Payload: outboxentity.Payload(`{"wallet_id":1}`)}},
+				{},
+			},
+			expectedRequest: &request.StreamParams{WalletID: []uint{1, 2}, Type: []string{"asset.deposited"},
+				LastEventID: &lastEventID},
+			expectedBody: "id: 42\nevent: asset.deposited\ndata: {\"created_at\":\"2026-10-16T12:00:00Z\",\"id\":7," +
+				"\"type\":\"asset.deposited\",\"aggregate_type\":\"asset\",\"aggregate_id\":3," +
+				"\"payload\":{\"wallet_id\":1}}\n\n: keep-alive\n\n",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "when the last event is given as a query parameter then should resume after it",
+			query:           "?last_event_id=41",
+			mockService:     true,
+			expectedRequest: &request.StreamParams{LastEventID: &lastEventID},
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:                 "when the last event ID is invalid then should return bad request",
+			lastEventID:          "abc",
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "invalid Last-Event-ID",
+		},
+		{
+			name:                 "when the event type is unknown then should return bad request",
+			query:                "?type=asset.burned",
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "type: (0: must be a valid value.)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := streammock.NewMockStreamService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().Follow(mock.Anything, tt.expectedRequest, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *request.StreamParams,
+						send func([]*entity.Event) error) error {
+						for _, batch := range tt.mockBatches {
+							err := send(batch)
+							if err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/stream"+tt.query, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set(HeaderLastEventID, tt.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Stream(ctx)
+
+			if tt.expectedStatus >= http.StatusBadRequest {
+				assert.Error(t, err)
+				httpErr := err.(*echo.HTTPError)
+				assert.Equal(t, tt.expectedStatus, httpErr.Code)
+				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, tt.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+func TestHandler_Close(t *testing.T) {
+	e := echo.New()
+	mockService := streammock.NewMockStreamService(t)
+	handler := NewHandler(mockService)
+
+	mockService.EXPECT().Follow(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ *request.StreamParams, _ func([]*entity.Event) error) error {
+			handler.Close()
+			<-ctx.Done()
+			return nil
+		}).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	rec := httptest.NewRecorder()
+
+	err := handler.Stream(e.NewContext(req, rec))
+
+	assert.NoError(t, err)
+}
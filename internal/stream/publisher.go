@@ -0,0 +1,40 @@
+package stream
+
+import (
+	"context"
+	"github.com/lib/pq"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+)
+
+// Publisher is the outbox publisher that appends events to the stream
+type Publisher struct {
+	repository Repository
+}
+
+// NewPublisher initializes a new Publisher appending published events to the stream of the repository
+func NewPublisher(repository Repository) *Publisher {
+	return &Publisher{repository: repository}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event *outboxentity.Event) error {
+	walletIDs, err := event.WalletIDs()
+	if err != nil {
+		return err
+	}
+
+	item := entity.Event{
+		CreatedAt:     event.CreatedAt,
+		EventID:       event.ID,
+		Type:          event.Type,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		WalletIDs:     make(pq.Int64Array, 0, len(walletIDs)),
+		Payload:       event.Payload,
+	}
+	for _, id := range walletIDs {
+		item.WalletIDs = append(item.WalletIDs, int64(id))
+	}
+
+	return p.repository.AppendEvent(ctx, &item)
+}
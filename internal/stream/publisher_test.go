@@ -0,0 +1,31 @@
+package stream
+
+import (
+	"context"
+	"github.com/lib/pq"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	streammock "github.com/safayildirim/asset-management-service/internal/stream/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	createdAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	payload := outboxentity.Payload(`{"id":4,"source_wallet_id":10,"destination_wallet_id":20,"status":"completed"}`)
+
+	mockRepository := streammock.NewMockStreamRepository(t)
+	publisher := NewPublisher(mockRepository)
+
+	mockRepository.EXPECT().AppendEvent(mock.Anything, &entity.Event{CreatedAt: createdAt, EventID: 9,
+		Type: outboxentity.TransactionCompleted, AggregateType: outboxentity.AggregateTransaction, AggregateID: 4,
+		WalletIDs: pq.Int64Array{10, 20}, Payload: payload}).Return(nil).Once()
+
+	err := publisher.Publish(context.Background(), &outboxentity.Event{ID: 9, CreatedAt: createdAt,
+		Type: outboxentity.TransactionCompleted, AggregateType: outboxentity.AggregateTransaction, AggregateID: 4,
+		Payload: payload})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,83 @@
+package stream
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// appendLockKey is the key of the advisory lock serializing the appends to the stream
+const appendLockKey = 7265730
+
+type Repository interface {
+	AppendEvent(ctx context.Context, item *entity.Event) error
+	GetEvents(ctx context.Context, filters entity.Filters, limit int) ([]*entity.Event, error)
+	GetLastSequence(ctx context.Context) (uint, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// AppendEvent adds an event at the end of the stream, unless it was appended before.
+//
+// Appends take a transaction-level advisory lock before drawing their sequence, so they commit one after the other in
+// the order of their sequences. A reader that sees a sequence has therefore seen every lower one as well, and never
+// skips an event committed late.
+func (r *repository) AppendEvent(ctx context.Context, item *entity.Event) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", appendLockKey).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "event_id"}}, DoNothing: true}).
+			Create(item).Error
+	})
+}
+
+// GetEvents fetches up to limit events in the order of their sequence
+func (r *repository) GetEvents(ctx context.Context, filters entity.Filters, limit int) ([]*entity.Event, error) {
+	var events []*entity.Event
+
+	query := r.db.WithContext(ctx).Model(&entity.Event{}).Where("sequence > ?", filters.After)
+
+	if filters.Until > 0 {
+		query = query.Where("sequence <= ?", filters.Until)
+	}
+	if len(filters.Type) > 0 {
+		query = query.Where("type IN ?", filters.Type)
+	}
+	if len(filters.WalletID) > 0 {
+		walletIDs := make(pq.Int64Array, 0, len(filters.WalletID))
+		for _, id := range filters.WalletID {
+			walletIDs = append(walletIDs, int64(id))
+		}
+		query = query.Where("wallet_ids && ?", walletIDs)
+	}
+
+	err := query.Order("sequence").Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetLastSequence returns the sequence of the last event of the stream, or 0 if the stream is empty
+func (r *repository) GetLastSequence(ctx context.Context) (uint, error) {
+	var sequence uint
+
+	err := r.db.WithContext(ctx).Model(&entity.Event{}).Select("COALESCE(MAX(sequence), 0)").Scan(&sequence).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
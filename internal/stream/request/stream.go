@@ -0,0 +1,29 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+)
+
+type StreamParams struct {
+	WalletID []uint   `json:"wallet_id" schema:"wallet_id"`
+	Type     []string `json:"type" schema:"type"`
+	// LastEventID is the ID of the last event the client received; the stream starts with the events that follow it.
+	// It is taken from the Last-Event-ID header when present. Without it, the stream starts with the next new event.
+	LastEventID *uint `json:"last_event_id" schema:"last_event_id"`
+}
+
+func (r StreamParams) Validate() error {
+	types := make([]interface{}, 0, len(outboxentity.EventTypes))
+	for _, eventType := range outboxentity.EventTypes {
+		types = append(types, string(eventType))
+	}
+
+	fields := []*validation.FieldRules{
+		validation.Field(&r.WalletID, validation.Each(validation.Required)),
+		validation.Field(&r.Type, validation.Each(validation.In(types...))),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "stream validation error")
+}
@@ -0,0 +1,97 @@
+package stream
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	"github.com/safayildirim/asset-management-service/internal/stream/request"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"time"
+)
+
+type Service interface {
+	Follow(ctx context.Context, request *request.StreamParams, send func(events []*entity.Event) error) error
+}
+
+type service struct {
+	cfg              config.StreamConfig
+	streamRepository Repository
+}
+
+func NewService(cfg config.StreamConfig, streamRepository Repository) Service {
+	return &service{cfg: cfg, streamRepository: streamRepository}
+}
+
+// Follow passes the events matching the request to send, in the order of the stream, as they are appended to it.
+//
+// Parameters:
+//   - ctx: The context of the stream; following stops without error once it is cancelled.
+//   - request: The filters of the stream and the ID of the last event the client received, if any.
+//   - send: Receives the events in batches. It is called without events once no event was sent for a heartbeat
+//     interval, so that the caller can keep the connection alive.
+//
+// Returns:
+//   - An error if the stream cannot be read, or the error returned by send.
+func (s *service) Follow(ctx context.Context, request *request.StreamParams,
+	send func(events []*entity.Event) error) error {
+	var (
+		after uint
+		err   error
+	)
+	if request.LastEventID != nil {
+		after = *request.LastEventID
+	} else {
+		after, err = s.streamRepository.GetLastSequence(ctx)
+		if err != nil {
+			return ignoreCancellation(ctx, err)
+		}
+	}
+
+	pollInterval := time.Duration(s.cfg.PollInterval) * time.Millisecond
+	heartbeatInterval := time.Duration(s.cfg.HeartbeatInterval) * time.Second
+	lastSent := time.Now()
+
+	for {
+		// Scanning up to the current end of the stream lets the position move past events that do not match the
+		// filters, so that they are not scanned again on the next poll
+		until, err := s.streamRepository.GetLastSequence(ctx)
+		if err != nil {
+			return ignoreCancellation(ctx, err)
+		}
+
+		events, err := s.streamRepository.GetEvents(ctx, entity.Filters{After: after, Until: until,
+			Type: request.Type, WalletID: request.WalletID}, s.cfg.BatchSize)
+		if err != nil {
+			return ignoreCancellation(ctx, err)
+		}
+
+		if len(events) > 0 || time.Since(lastSent) >= heartbeatInterval {
+			err = send(events)
+			if err != nil {
+				return err
+			}
+			lastSent = time.Now()
+		}
+
+		// A full batch means the client is catching up, so the next batch is read right away
+		if len(events) == s.cfg.BatchSize {
+			after = events[len(events)-1].Sequence
+			continue
+		}
+		after = max(after, until)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// ignoreCancellation drops the error of a read interrupted by the client going away
+func ignoreCancellation(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
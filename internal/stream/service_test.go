@@ -0,0 +1,131 @@
+package stream
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/stream/entity"
+	streammock "github.com/safayildirim/asset-management-service/internal/stream/mock"
+	"github.com/safayildirim/asset-management-service/internal/stream/request"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestService_Follow(t *testing.T) {
+	lastEventID := uint(5)
+
+	type poll struct {
+		lastSequence uint
+		filters      entity.Filters
+		events       []*entity.Event
+		err          error
+	}
+
+	tests := []struct {
+		name              string
+		cfg               config.StreamConfig
+		request           *request.StreamParams
+		mockStart         bool
+		mockStartSequence uint
+		polls             []poll
+		expectedBatches   [][]uint
+		expectedError     error
+	}{
+		{
+			name:    "when the client resumes then should send the events after its last event",
+			cfg:     config.StreamConfig{PollInterval: 1, BatchSize: 10, HeartbeatInterval: 60},
+			request: &request.StreamParams{LastEventID: &lastEventID, Type: []string{"asset.deposited"}},
+			polls: []poll{
+				{lastSequence: 8, filters: entity.Filters{After: 5, Until: 8, Type: []string{"asset.deposited"}},
+					events: []*entity.Event{{Sequence: 6}, {Sequence: 8}}},
+			},
+			expectedBatches: [][]uint{{6, 8}},
+		},
+		{
+			name:              "when the client connects without a last event then should start with new events",
+			cfg:               config.StreamConfig{PollInterval: 1, BatchSize: 10, HeartbeatInterval: 60},
+			request:           &request.StreamParams{WalletID: []uint{1}},
+			mockStart:         true,
+			mockStartSequence: 10,
+			polls: []poll{
+				{lastSequence: 10, filters: entity.Filters{After: 10, Until: 10, WalletID: []uint{1}}},
+				{lastSequence: 12, filters: entity.Filters{After: 10, Until: 12, WalletID: []uint{1}},
+					events: []*entity.Event{{Sequence: 12}}},
+			},
+			expectedBatches: [][]uint{{12}},
+		},
+		{
+			name:    "when the client is behind by more than a batch then should read the next batch right away",
+			cfg:     config.StreamConfig{PollInterval: 1, BatchSize: 2, HeartbeatInterval: 60},
+			request: &request.StreamParams{LastEventID: &lastEventID},
+			polls: []poll{
+				{lastSequence: 20, filters: entity.Filters{After: 5, Until: 20},
+					events: []*entity.Event{{Sequence: 6}, {Sequence: 7}}},
+				{lastSequence: 20, filters: entity.Filters{After: 7, Until: 20},
+					events: []*entity.Event{{Sequence: 9}}},
+			},
+			expectedBatches: [][]uint{{6, 7}, {9}},
+		},
+		{
+			name:    "when no events were sent for the heartbeat interval then should send an empty batch",
+			cfg:     config.StreamConfig{PollInterval: 1, BatchSize: 10, HeartbeatInterval: 0},
+			request: &request.StreamParams{LastEventID: &lastEventID},
+			polls: []poll{
+				{lastSequence: 5, filters: entity.Filters{After: 5, Until: 5}},
+			},
+			expectedBatches: [][]uint{{}},
+		},
+		{
+			name:    "when the stream cannot be read then should return error",
+			cfg:     config.StreamConfig{PollInterval: 1, BatchSize: 10, HeartbeatInterval: 60},
+			request: &request.StreamParams{LastEventID: &lastEventID},
+			polls: []poll{
+				{lastSequence: 8, filters: entity.Filters{After: 5, Until: 8}, err: errors.New("connection refused")},
+			},
+			expectedError: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := streammock.NewMockStreamRepository(t)
+			s := NewService(tt.cfg, mockRepository)
+
+			if tt.mockStart {
+				mockRepository.EXPECT().GetLastSequence(mock.Anything).Return(tt.mockStartSequence, nil).Once()
+			}
+			for _, p := range tt.polls {
+				mockRepository.EXPECT().GetLastSequence(mock.Anything).Return(p.lastSequence, nil).Once()
+				mockRepository.EXPECT().GetEvents(mock.Anything, p.filters, tt.cfg.BatchSize).
+					Return(p.events, p.err).Once()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var batches [][]uint
+			err := s.Follow(ctx, tt.request, func(events []*entity.Event) error {
+				sequences := []uint{}
+				for _, event := range events {
+					sequences = append(sequences, event.Sequence)
+				}
+				batches = append(batches, sequences)
+
+				// The client goes away once it received everything expected of it
+				if len(batches) == len(tt.expectedBatches) {
+					cancel()
+				}
+				return nil
+			})
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBatches, batches)
+		})
+	}
+}
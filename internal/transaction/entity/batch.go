@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Batch groups transfers that are executed all-or-nothing in a single database transaction. Its legs are stored as
+// transactions referencing the batch and are only ever executed together with it.
+type Batch struct {
+	ID             uint              `json:"id"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      null.Time         `json:"updated_at"`
+	Status         TransactionStatus `json:"status"`
+	ScheduledAt    time.Time         `json:"scheduled_at"`
+	FailureReason  null.String       `json:"failure_reason"`
+	Attempts       int               `json:"attempts"`
+	LastAttemptAt  null.Time         `json:"last_attempt_at"`
+	NextAttemptAt  null.Time         `json:"next_attempt_at"`
+	ClaimedBy      null.String       `json:"-"`
+	ClaimExpiresAt null.Time         `json:"-"`
+	Legs           []*Transaction    `json:"legs" gorm:"-"`
+}
+
+func (Batch) TableName() string {
+	return "transfer_batches"
+}
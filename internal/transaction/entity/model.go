@@ -1,12 +1,25 @@
 package entity
 
-import "time"
+import (
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+	"time"
+)
 
 type Filters struct {
 	ID                  []uint
 	SourceWalletID      []uint
 	DestinationWalletID []uint
 	Status              []string
+	RecurringScheduleID []uint
+	BatchID             []uint
+	AssetName           []string
+	MinAmount           *decimal.Decimal
+	MaxAmount           *decimal.Decimal
 	ScheduledStart      time.Time
 	ScheduledEnd        time.Time
+	CreatedStart        time.Time
+	CreatedEnd          time.Time
+	// Page restricts the result to a page of the listing; all matching transactions are returned when it is nil
+	Page *common.PageQuery
 }
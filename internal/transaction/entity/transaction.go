@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/shopspring/decimal"
 	"gopkg.in/guregu/null.v3"
 	"time"
 )
@@ -12,9 +13,20 @@ type Transaction struct {
 	SourceWalletID      uint              `json:"source_wallet_id"`
 	DestinationWalletID uint              `json:"destination_wallet_id"`
 	AssetName           string            `json:"asset_name"`
-	Amount              float64           `json:"amount"`
+	Amount              decimal.Decimal   `json:"amount"`
 	Status              TransactionStatus `json:"status"`
 	ScheduledAt         time.Time         `json:"scheduled_at"`
+	RecurringScheduleID null.Int          `json:"recurring_schedule_id"`
+	BatchID             null.Int          `json:"batch_id"`
+	ReversalOf          null.Int          `json:"reversal_of"`
+	ReversedAmount      decimal.Decimal   `json:"reversed_amount"`
+	Held                bool              `json:"held"`
+	FailureReason       null.String       `json:"failure_reason"`
+	Attempts            int               `json:"attempts"`
+	LastAttemptAt       null.Time         `json:"last_attempt_at"`
+	NextAttemptAt       null.Time         `json:"next_attempt_at"`
+	ClaimedBy           null.String       `json:"-"`
+	ClaimExpiresAt      null.Time         `json:"-"`
 }
 
 func (Transaction) TableName() string {
@@ -28,4 +40,24 @@ const (
 	TransactionFailed    TransactionStatus = "failed"
 	TransactionPending   TransactionStatus = "pending"
 	TransactionCancelled TransactionStatus = "cancelled"
+	TransactionReversed  TransactionStatus = "reversed"
 )
+
+// FailureReason classifies why the scheduler could not execute a transaction
+type FailureReason string
+
+const (
+	FailureInsufficientBalance FailureReason = "insufficient_balance"
+	FailureInsufficientHold    FailureReason = "insufficient_hold"
+	FailureWalletNotFound      FailureReason = "wallet_not_found"
+	FailureWalletDeleted       FailureReason = "wallet_deleted"
+	FailureAssetUnavailable    FailureReason = "asset_unavailable"
+	FailureConcurrentUpdate    FailureReason = "concurrent_update"
+	FailureInternalError       FailureReason = "internal_error"
+)
+
+// Transient reports whether a failure may not happen again, such as a timeout or a balance that kept changing
+// concurrently, as opposed to business failures like an insufficient balance
+func (r FailureReason) Transient() bool {
+	return r == FailureInternalError || r == FailureConcurrentUpdate
+}
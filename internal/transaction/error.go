@@ -1,10 +1,30 @@
 package transaction
 
-import "github.com/pkg/errors"
+import (
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
 
 var (
-	ErrTransactionNotFound        = errors.New("transaction not found")
-	ErrTransactionCannotBeDeleted = errors.New("transaction cannot be deleted")
-	ErrAssetNotFound              = errors.New("asset not found")
-	ErrInsufficientBalance        = errors.New("insufficient balance")
+	ErrTransactionNotFound = apperror.New(apperror.CodeTransactionNotFound, http.StatusNotFound,
+		"transaction not found")
+	ErrTransactionCannotBeDeleted = apperror.New(apperror.CodeTransactionNotCancelable, http.StatusConflict,
+		"transaction cannot be deleted")
+	ErrAssetNotFound       = apperror.New(apperror.CodeAssetNotFound, http.StatusNotFound, "asset not found")
+	ErrInsufficientBalance = apperror.New(apperror.CodeInsufficientBalance, http.StatusConflict,
+		"insufficient balance")
+	ErrTransactionCannotBeReversed = apperror.New(apperror.CodeTransactionNotReversible, http.StatusConflict,
+		"transaction cannot be reversed")
+	ErrTransactionCannotBeAmended = apperror.New(apperror.CodeTransactionNotAmendable, http.StatusConflict,
+		"transaction cannot be amended")
+	ErrConcurrentAmendment = apperror.New(apperror.CodeConcurrentUpdate, http.StatusConflict,
+		"transaction was amended concurrently")
+	ErrSameWallet = apperror.New(apperror.CodeSameWallet, http.StatusBadRequest,
+		"source and destination wallets must be different")
+	ErrReversalExceedsAmount = apperror.New(apperror.CodeReversalExceedsAmount, http.StatusBadRequest,
+		"reversal amount exceeds the amount not reversed yet")
+
+	// ErrClaimLost is internal to the scheduler and never reaches a client
+	ErrClaimLost = errors.New("transaction is no longer claimed by this scheduler")
 )
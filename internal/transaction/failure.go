@@ -0,0 +1,31 @@
+package transaction
+
+import (
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+)
+
+// ClassifyFailure maps an error raised while executing or scheduling a transaction to the reason stored on it when it
+// fails
+func ClassifyFailure(err error) entity.FailureReason {
+	switch {
+	case errors.Is(err, asset.ErrInsufficientBalance):
+		return entity.FailureInsufficientBalance
+	case errors.Is(err, asset.ErrInsufficientHold):
+		return entity.FailureInsufficientHold
+	case errors.Is(err, asset.ErrConcurrentUpdate):
+		return entity.FailureConcurrentUpdate
+	case errors.Is(err, wallet.ErrWalletNotFound):
+		return entity.FailureWalletNotFound
+	case errors.Is(err, wallet.ErrWalletDeleted):
+		return entity.FailureWalletDeleted
+	case errors.Is(err, catalog.ErrUnknownAsset), errors.Is(err, catalog.ErrAssetDisabled),
+		errors.Is(err, catalog.ErrAmountPrecision), errors.Is(err, catalog.ErrAmountBelowMinimum):
+		return entity.FailureAssetUnavailable
+	default:
+		return entity.FailureInternalError
+	}
+}
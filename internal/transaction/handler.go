@@ -3,13 +3,13 @@ package transaction
 import (
 	"github.com/gorilla/schema"
 	"github.com/labstack/echo/v4"
-	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/common"
 	"github.com/safayildirim/asset-management-service/internal/transaction/request"
-	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/shopspring/decimal"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 )
 
 var decoder = schema.NewDecoder()
@@ -18,6 +18,22 @@ func init() {
 	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
 		return reflect.ValueOf(strings.Split(value, ","))
 	})
+	// Parse amount filters as exact decimals; an invalid value yields a decoding error
+	decoder.RegisterConverter(decimal.Decimal{}, func(value string) reflect.Value {
+		amount, err := decimal.NewFromString(value)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(amount)
+	})
+	// Parse time range filters as RFC 3339 timestamps; an invalid value yields a decoding error
+	decoder.RegisterConverter(time.Time{}, func(value string) reflect.Value {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(t)
+	})
 }
 
 type Handler struct {
@@ -30,8 +46,13 @@ func NewHandler(transactionService Service) *Handler {
 
 func (h Handler) RegisterRoutes(e *echo.Group) {
 	e.POST("/transactions/schedule", h.ScheduleTransaction)
+	e.POST("/transfers", h.Transfer)
+	e.POST("/transfers/batch", h.TransferBatch)
+	e.POST("/transactions/:id/reverse", h.ReverseTransaction)
+	e.PATCH("/transactions/:id", h.AmendTransaction)
 	e.GET("/transactions", h.GetTransactions)
-	e.DELETE("/transactions", h.DeleteTransaction)
+	e.GET("/transactions/:id", h.GetTransaction)
+	e.DELETE("/transactions/:id", h.DeleteTransaction)
 }
 
 func (h Handler) ScheduleTransaction(ctx echo.Context) error {
@@ -46,17 +67,94 @@ func (h Handler) ScheduleTransaction(ctx echo.Context) error {
 
 	transaction, err := h.transactionService.ScheduleTransaction(ctx.Request().Context(), &req)
 	if err != nil {
-		switch {
-		case errors.Is(err, walletpkg.ErrWalletNotFound):
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-		}
+		return err
+	}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	return ctx.JSON(http.StatusCreated, common.Response{Data: transaction})
+}
+
+func (h Handler) Transfer(ctx echo.Context) error {
+	var req request.TransferRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	transaction, err := h.transactionService.Transfer(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
 	}
 
 	return ctx.JSON(http.StatusCreated, common.Response{Data: transaction})
 }
 
+func (h Handler) TransferBatch(ctx echo.Context) error {
+	var req request.BatchTransferRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	batch, err := h.transactionService.TransferBatch(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, common.Response{Data: batch})
+}
+
+func (h Handler) ReverseTransaction(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var req request.ReverseTransactionRequest
+	if err = ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err = req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	reversal, err := h.transactionService.ReverseTransaction(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, common.Response{Data: reversal})
+}
+
+func (h Handler) AmendTransaction(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var req request.AmendTransactionRequest
+	if err = ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err = req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	transaction, err := h.transactionService.AmendTransaction(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: transaction})
+}
+
 func (h Handler) GetTransactions(ctx echo.Context) error {
 	var req request.GetTransactionsParams
 	params := ctx.QueryParams()
@@ -71,12 +169,26 @@ func (h Handler) GetTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	assets, err := h.transactionService.GetTransactions(ctx.Request().Context(), &req)
+	page, err := h.transactionService.GetTransactions(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.NewPageResponse(page))
+}
+
+func (h Handler) GetTransaction(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	transaction, err := h.transactionService.GetTransaction(ctx.Request().Context(), id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, common.Response{Data: assets})
+	return ctx.JSON(http.StatusOK, common.Response{Data: transaction})
 }
 
 func (h Handler) DeleteTransaction(ctx echo.Context) error {
@@ -87,7 +199,7 @@ func (h Handler) DeleteTransaction(ctx echo.Context) error {
 
 	err = h.transactionService.CancelTransaction(ctx.Request().Context(), id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
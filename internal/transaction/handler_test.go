@@ -3,27 +3,37 @@ package transaction
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
 	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
 	transactionmock "github.com/safayildirim/asset-management-service/internal/transaction/mock"
+	"github.com/safayildirim/asset-management-service/internal/transaction/request"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
 	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestHandler_GetTransactions(t *testing.T) {
 	e := echo.New()
 
+	minAmount := decimal.RequireFromString("0.5")
+	scheduledStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name                 string
 		query                map[string]string
 		mockService          bool
-		mockReturnData       []*entity.Transaction
+		mockReturnData       *common.Page[*entity.Transaction]
 		mockReturnErr        error
+		expectedRequest      *request.GetTransactionsParams
 		expectedStatus       int
+		expectedBody         string
 		expectErr            bool
 		expectedErrorMessage string
 	}{
@@ -36,17 +46,74 @@ func TestHandler_GetTransactions(t *testing.T) {
 				"status":                "pending",
 			},
 			mockService: true,
-			mockReturnData: []*entity.Transaction{
+			mockReturnData: &common.Page[*entity.Transaction]{Items: []*entity.Transaction{
 				{ID: 1, SourceWalletID: 1001, DestinationWalletID: 1003, Status: "pending"},
-			},
+			}, Limit: common.DefaultPageLimit},
 			mockReturnErr:  nil,
 			expectedStatus: http.StatusOK,
+			expectedBody:   `"pagination":{"limit":50}`,
 		},
 		{
-			name:           "when no query parameters are provided then should return all transactions",
-			query:          nil,
-			mockService:    true,
-			mockReturnData: []*entity.Transaction{},
+			name: "when range filters and pagination are provided then should pass them to the service",
+			query: map[string]string{
+				"asset_name":      "btc,eth",
+				"min_amount":      "0.5",
+				"scheduled_start": "2026-10-01T00:00:00Z",
+				"limit":           "10",
+				"sort":            "-scheduled_at",
+				"cursor":          common.Cursor{Sort: "-scheduled_at", Value: "2026-10-02T00:00:00Z", ID: 4}.Encode(),
+			},
+			mockService: true,
+			mockReturnData: &common.Page[*entity.Transaction]{Items: []*entity.Transaction{{ID: 3}}, Limit: 10,
+				NextCursor: "next"},
+			expectedRequest: &request.GetTransactionsParams{
+				AssetName:      []string{"btc", "eth"},
+				MinAmount:      &minAmount,
+				ScheduledStart: scheduledStart,
+				Limit:          10,
+				Sort:           "-scheduled_at",
+				Cursor:         common.Cursor{Sort: "-scheduled_at", Value: "2026-10-02T00:00:00Z", ID: 4}.Encode(),
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"pagination":{"limit":10,"next_cursor":"next"}`,
+		},
+		{
+			name: "when created range ends before it starts then should return bad request",
+			query: map[string]string{
+				"created_start": "2026-10-02T00:00:00Z",
+				"created_end":   "2026-10-01T00:00:00Z",
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "created_end: must not be before created_start",
+		},
+		{
+			name:                 "when scheduled start is not a timestamp then should return bad request",
+			query:                map[string]string{"scheduled_start": "yesterday"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "scheduled_start",
+		},
+		{
+			name:                 "when sort field is not supported then should return bad request",
+			query:                map[string]string{"sort": "status"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "sort: must be one of id, created_at, scheduled_at, amount",
+		},
+		{
+			name:                 "when cursor is malformed then should return bad request",
+			query:                map[string]string{"cursor": "%%%"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "cursor: invalid cursor",
+		},
+		{
+			name:        "when no query parameters are provided then should return all transactions",
+			query:       nil,
+			mockService: true,
+			mockReturnData: &common.Page[*entity.Transaction]{Items: []*entity.Transaction{},
+				Limit: common.DefaultPageLimit},
 			mockReturnErr:  nil,
 			expectedStatus: http.StatusOK,
 		},
@@ -85,7 +152,11 @@ func TestHandler_GetTransactions(t *testing.T) {
 			handler := NewHandler(mockService)
 
 			if tt.mockService {
-				mockService.EXPECT().GetTransactions(mock.Anything, mock.Anything).Return(tt.mockReturnData,
+				var expectedRequest interface{} = mock.Anything
+				if tt.expectedRequest != nil {
+					expectedRequest = tt.expectedRequest
+				}
+				mockService.EXPECT().GetTransactions(mock.Anything, expectedRequest).Return(tt.mockReturnData,
 					tt.mockReturnErr).Once()
 			}
 
@@ -101,16 +172,150 @@ func TestHandler_GetTransactions(t *testing.T) {
 			err := handler.GetTransactions(ctx)
 			if tt.expectErr {
 				assert.Error(t, err)
-				httpErr := err.(*echo.HTTPError)
-				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestHandler_GetTransaction(t *testing.T) {
+	e := echo.New()
 
+	tests := []struct {
+		name                 string
+		transactionID        string
+		mockService          bool
+		mockReturn           *entity.Transaction
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when transaction exists then should return it",
+			transactionID:  "1",
+			mockService:    true,
+			mockReturn:     &entity.Transaction{ID: 1, Status: entity.TransactionPending},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when invalid transaction ID is provided then should return bad request",
+			transactionID:        "invalid",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when transaction not found then should return not found",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            ErrTransactionNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "transaction not found",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().GetTransaction(mock.Anything, uint(1)).Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/transactions/:id", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/transactions/:id")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.transactionID)
+
+			err := handler.GetTransaction(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), `"id":1`)
 			}
 		})
 	}
 }
 
+func TestHandler_RegisterRoutes(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		mock           func(mockService *transactionmock.MockTransactionService)
+		expectedStatus int
+	}{
+		{
+			name:   "when a single transaction is requested then should route to get transaction",
+			method: http.MethodGet,
+			path:   "/api/transactions/7",
+			mock: func(mockService *transactionmock.MockTransactionService) {
+				mockService.EXPECT().GetTransaction(mock.Anything, uint(7)).Return(&entity.Transaction{ID: 7}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "when a transaction is deleted then should route to cancel transaction with its ID",
+			method: http.MethodDelete,
+			path:   "/api/transactions/7",
+			mock: func(mockService *transactionmock.MockTransactionService) {
+				mockService.EXPECT().CancelTransaction(mock.Anything, uint(7)).Return(nil).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "when a missing transaction is deleted then should respond not found",
+			method: http.MethodDelete,
+			path:   "/api/transactions/8",
+			mock: func(mockService *transactionmock.MockTransactionService) {
+				mockService.EXPECT().CancelTransaction(mock.Anything, uint(8)).Return(ErrTransactionNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			tt.mock(mockService)
+
+			e := echo.New()
+			e.HTTPErrorHandler = apperror.HTTPErrorHandler
+			NewHandler(mockService).RegisterRoutes(e.Group("/api"))
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
 func TestHandler_DeleteTransaction(t *testing.T) {
 	e := echo.New()
 
@@ -143,11 +348,20 @@ func TestHandler_DeleteTransaction(t *testing.T) {
 			name:                 "when transaction not found then should return not found",
 			transactionID:        "2",
 			mockService:          true,
-			mockError:            errors.New("transaction not found"),
-			expectedStatus:       http.StatusInternalServerError,
+			mockError:            ErrTransactionNotFound,
+			expectedStatus:       http.StatusNotFound,
 			expectErr:            true,
 			expectedErrorMessage: "transaction not found",
 		},
+		{
+			name:                 "when transaction cannot be cancelled then should return conflict",
+			transactionID:        "2",
+			mockService:          true,
+			mockError:            ErrTransactionCannotBeDeleted,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "transaction cannot be deleted",
+		},
 		{
 			name:                 "when service returns error then should return internal server error",
 			transactionID:        "3",
@@ -179,11 +393,11 @@ func TestHandler_DeleteTransaction(t *testing.T) {
 
 			if tt.expectErr {
 				assert.Error(t, err)
-				httpErr := err.(*echo.HTTPError)
-				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
 			} else {
 				assert.NoError(t, err)
-
+				assert.Equal(t, tt.expectedStatus, rec.Code)
 			}
 		})
 	}
@@ -212,7 +426,7 @@ func TestHandler_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10,
+				Amount:              decimal.NewFromInt(10),
 				Status:              "pending",
 			},
 			mockError:      nil,
@@ -222,7 +436,7 @@ func TestHandler_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10,
+				Amount:              decimal.NewFromInt(10),
 				Status:              "pending",
 			},
 		},
@@ -233,7 +447,7 @@ func TestHandler_ScheduleTransaction(t *testing.T) {
 			mockError:            nil,
 			expectedStatus:       http.StatusBadRequest,
 			expectErr:            true,
-			expectedErrorMessage: "Unmarshal type error",
+			expectedErrorMessage: "can't convert invalid to decimal",
 		},
 		{
 			name:                 "when asset name is empty then should return bad request",
@@ -285,10 +499,435 @@ func TestHandler_ScheduleTransaction(t *testing.T) {
 
 			if tt.expectErr {
 				assert.Error(t, err)
-				httpErr := err.(*echo.HTTPError)
-				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler_Transfer(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Transaction
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when valid request body is provided then should transfer and return created",
+			body:        `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"10"}`,
+			mockService: true,
+			mockReturn: &entity.Transaction{
+				ID:                  1,
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "BTC",
+				Amount:              decimal.NewFromInt(10),
+				Status:              entity.TransactionCompleted,
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when source and destination wallets are the same then should return bad request",
+			body:                 `{"source_wallet_id":1,"destination_wallet_id":1,"asset_name":"BTC","amount":"10"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "destination_wallet_id: must be different from source_wallet_id",
+		},
+		{
+			name:                 "when amount is not positive then should return bad request",
+			body:                 `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"0"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "amount: must be greater than zero",
+		},
+		{
+			name:                 "when source balance is insufficient then should return conflict",
+			body:                 `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"10"}`,
+			mockService:          true,
+			mockError:            ErrInsufficientBalance,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "insufficient balance",
+		},
+		{
+			name:                 "when wallet not found then should return bad request",
+			body:                 `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"10"}`,
+			mockService:          true,
+			mockError:            walletpkg.ErrWalletNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "wallet not found",
+		},
+		{
+			name:                 "when internal server error then should return internal server error",
+			body:                 `{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"10"}`,
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectErr:            true,
+			expectedStatus:       http.StatusInternalServerError,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().Transfer(mock.Anything, mock.Anything).Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transfers", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Transfer(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_TransferBatch(t *testing.T) {
+	e := echo.New()
+
+	legs := `[{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1"},` +
+		`{"source_wallet_id":1,"destination_wallet_id":3,"asset_name":"BTC","amount":"2"}]`
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Batch
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when valid legs are provided then should transfer and return created",
+			body:           `{"legs":` + legs + `}`,
+			mockService:    true,
+			mockReturn:     &entity.Batch{ID: 1, Status: entity.TransactionCompleted},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when no legs are provided then should return bad request",
+			body:                 `{"legs":[]}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "legs: cannot be blank",
+		},
+		{
+			name: "when a leg is invalid then should return bad request naming the leg",
+			body: `{"legs":[{"source_wallet_id":1,"destination_wallet_id":2,"asset_name":"BTC","amount":"1"},` +
+				`{"source_wallet_id":1,"destination_wallet_id":1,"asset_name":"BTC","amount":"1"}]}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "1: (destination_wallet_id: must be different from source_wallet_id.)",
+		},
+		{
+			name:                 "when a source balance is insufficient then should return conflict",
+			body:                 `{"legs":` + legs + `,"scheduled_at":"2030-01-01T00:00:00Z"}`,
+			mockService:          true,
+			mockError:            errors.Wrap(ErrInsufficientBalance, "wallet 1 BTC"),
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "wallet 1 BTC: insufficient balance",
+		},
+		{
+			name:                 "when a wallet is not found then should return bad request",
+			body:                 `{"legs":` + legs + `}`,
+			mockService:          true,
+			mockError:            errors.Wrap(walletpkg.ErrWalletNotFound, "wallet 3"),
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "wallet 3: wallet not found",
+		},
+		{
+			name:                 "when internal server error then should return internal server error",
+			body:                 `{"legs":` + legs + `}`,
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectErr:            true,
+			expectedStatus:       http.StatusInternalServerError,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().TransferBatch(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transfers/batch", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.TransferBatch(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_ReverseTransaction(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		transactionID        string
+		body                 string
+		mockService          bool
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when transaction is reversed then should return created",
+			transactionID:  "1",
+			mockService:    true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "when partial reversal is requested then should return created",
+			transactionID:  "1",
+			body:           `{"amount":"2.5","allow_partial":true}`,
+			mockService:    true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when amount is not positive then should return bad request",
+			transactionID:        "1",
+			body:                 `{"amount":"-1"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "amount: must be greater than zero",
+		},
+		{
+			name:                 "when transaction ID is invalid then should return bad request",
+			transactionID:        "invalid",
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when transaction is not found then should return not found",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            ErrTransactionNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusNotFound,
+			expectedErrorMessage: "transaction not found",
+		},
+		{
+			name:                 "when transaction cannot be reversed then should return conflict",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            ErrTransactionCannotBeReversed,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "transaction cannot be reversed",
+		},
+		{
+			name:                 "when destination balance is insufficient then should return conflict",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            ErrInsufficientBalance,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "insufficient balance",
+		},
+		{
+			name:                 "when amount exceeds the rest then should return bad request",
+			transactionID:        "1",
+			mockService:          true,
+			mockError:            ErrReversalExceedsAmount,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "reversal amount exceeds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ReverseTransaction(mock.Anything, uint(1), mock.Anything).
+					Return(&entity.Transaction{ID: 2}, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transactions/:id/reverse", strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/transactions/:id/reverse")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.transactionID)
+
+			err := handler.ReverseTransaction(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_AmendTransaction(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		transactionID        string
+		body                 string
+		mockService          bool
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when valid changes are provided then should return the amended transaction",
+			transactionID:  "1",
+			body:           `{"amount":"2","scheduled_at":"2030-01-01T00:00:00Z"}`,
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when no change is provided then should return bad request",
+			transactionID:        "1",
+			body:                 `{}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "at least one of destination_wallet_id, amount or scheduled_at is required",
+		},
+		{
+			name:                 "when amount is not positive then should return bad request",
+			transactionID:        "1",
+			body:                 `{"amount":"0"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "amount: must be greater than zero",
+		},
+		{
+			name:                 "when transaction ID is invalid then should return bad request",
+			transactionID:        "invalid",
+			body:                 `{"amount":"2"}`,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when transaction is not found then should return not found",
+			transactionID:        "1",
+			body:                 `{"amount":"2"}`,
+			mockService:          true,
+			mockError:            ErrTransactionNotFound,
+			expectErr:            true,
+			expectedStatus:       http.StatusNotFound,
+			expectedErrorMessage: "transaction not found",
+		},
+		{
+			name:                 "when transaction cannot be amended then should return conflict",
+			transactionID:        "1",
+			body:                 `{"amount":"2"}`,
+			mockService:          true,
+			mockError:            ErrTransactionCannotBeAmended,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "transaction cannot be amended",
+		},
+		{
+			name:                 "when balance is insufficient then should return conflict",
+			transactionID:        "1",
+			body:                 `{"amount":"2"}`,
+			mockService:          true,
+			mockError:            ErrInsufficientBalance,
+			expectErr:            true,
+			expectedStatus:       http.StatusConflict,
+			expectedErrorMessage: "insufficient balance",
+		},
+		{
+			name:                 "when destination is the source then should return bad request",
+			transactionID:        "1",
+			body:                 `{"destination_wallet_id":1}`,
+			mockService:          true,
+			mockError:            ErrSameWallet,
+			expectErr:            true,
+			expectedStatus:       http.StatusBadRequest,
+			expectedErrorMessage: "source and destination wallets must be different",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := transactionmock.NewMockTransactionService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().AmendTransaction(mock.Anything, uint(1), mock.Anything).
+					Return(&entity.Transaction{ID: 1}, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/transactions/:id", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/transactions/:id")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.transactionID)
+
+			err := handler.AmendTransaction(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
 			}
 		})
 	}
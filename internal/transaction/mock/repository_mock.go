@@ -9,6 +9,8 @@ import (
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockTransactionRepository is an autogenerated mock type for the Repository type
@@ -24,9 +26,192 @@ func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecte
 	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// ClaimDueBatches provides a mock function with given fields: ctx, owner, now, lease, limit
+func (_m *MockTransactionRepository) ClaimDueBatches(ctx context.Context, owner string, now time.Time, lease time.Duration, limit int) ([]*entity.Batch, error) {
+	ret := _m.Called(ctx, owner, now, lease, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueBatches")
+	}
+
+	var r0 []*entity.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration, int) ([]*entity.Batch, error)); ok {
+		return rf(ctx, owner, now, lease, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration, int) []*entity.Batch); ok {
+		r0 = rf(ctx, owner, now, lease, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Duration, int) error); ok {
+		r1 = rf(ctx, owner, now, lease, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_ClaimDueBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueBatches'
+type MockTransactionRepository_ClaimDueBatches_Call struct {
+	*mock.Call
+}
+
+// ClaimDueBatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - now time.Time
+//   - lease time.Duration
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) ClaimDueBatches(ctx interface{}, owner interface{}, now interface{}, lease interface{}, limit interface{}) *MockTransactionRepository_ClaimDueBatches_Call {
+	return &MockTransactionRepository_ClaimDueBatches_Call{Call: _e.mock.On("ClaimDueBatches", ctx, owner, now, lease, limit)}
+}
+
+func (_c *MockTransactionRepository_ClaimDueBatches_Call) Run(run func(ctx context.Context, owner string, now time.Time, lease time.Duration, limit int)) *MockTransactionRepository_ClaimDueBatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Duration), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ClaimDueBatches_Call) Return(_a0 []*entity.Batch, _a1 error) *MockTransactionRepository_ClaimDueBatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ClaimDueBatches_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Duration, int) ([]*entity.Batch, error)) *MockTransactionRepository_ClaimDueBatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimDueTransactions provides a mock function with given fields: ctx, owner, now, lease, limit
+func (_m *MockTransactionRepository) ClaimDueTransactions(ctx context.Context, owner string, now time.Time, lease time.Duration, limit int) ([]*entity.Transaction, error) {
+	ret := _m.Called(ctx, owner, now, lease, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueTransactions")
+	}
+
+	var r0 []*entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration, int) ([]*entity.Transaction, error)); ok {
+		return rf(ctx, owner, now, lease, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration, int) []*entity.Transaction); ok {
+		r0 = rf(ctx, owner, now, lease, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Duration, int) error); ok {
+		r1 = rf(ctx, owner, now, lease, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_ClaimDueTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueTransactions'
+type MockTransactionRepository_ClaimDueTransactions_Call struct {
+	*mock.Call
+}
+
+// ClaimDueTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - now time.Time
+//   - lease time.Duration
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) ClaimDueTransactions(ctx interface{}, owner interface{}, now interface{}, lease interface{}, limit interface{}) *MockTransactionRepository_ClaimDueTransactions_Call {
+	return &MockTransactionRepository_ClaimDueTransactions_Call{Call: _e.mock.On("ClaimDueTransactions", ctx, owner, now, lease, limit)}
+}
+
+func (_c *MockTransactionRepository_ClaimDueTransactions_Call) Run(run func(ctx context.Context, owner string, now time.Time, lease time.Duration, limit int)) *MockTransactionRepository_ClaimDueTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Duration), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ClaimDueTransactions_Call) Return(_a0 []*entity.Transaction, _a1 error) *MockTransactionRepository_ClaimDueTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ClaimDueTransactions_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Duration, int) ([]*entity.Transaction, error)) *MockTransactionRepository_ClaimDueTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function with given fields: ctx, tx, batch
+func (_m *MockTransactionRepository) CreateBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch) (*entity.Batch, error) {
+	ret := _m.Called(ctx, tx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 *entity.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Batch) (*entity.Batch, error)); ok {
+		return rf(ctx, tx, batch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Batch) *entity.Batch); ok {
+		r0 = rf(ctx, tx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *entity.Batch) error); ok {
+		r1 = rf(ctx, tx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockTransactionRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - batch *entity.Batch
+func (_e *MockTransactionRepository_Expecter) CreateBatch(ctx interface{}, tx interface{}, batch interface{}) *MockTransactionRepository_CreateBatch_Call {
+	return &MockTransactionRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, tx, batch)}
+}
+
+func (_c *MockTransactionRepository_CreateBatch_Call) Run(run func(ctx context.Context, tx *gorm.DB, batch *entity.Batch)) *MockTransactionRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Batch))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CreateBatch_Call) Return(_a0 *entity.Batch, _a1 error) *MockTransactionRepository_CreateBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CreateBatch_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Batch) (*entity.Batch, error)) *MockTransactionRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, tx, _a2
-func (_m *MockTransactionRepository) CreateTransaction(ctx context.Context, tx *gorm.DB,
-	_a2 *entity.Transaction) (*entity.Transaction, error) {
+func (_m *MockTransactionRepository) CreateTransaction(ctx context.Context, tx *gorm.DB, _a2 *entity.Transaction) (*entity.Transaction, error) {
 	ret := _m.Called(ctx, tx, _a2)
 
 	if len(ret) == 0 {
@@ -64,27 +249,23 @@ type MockTransactionRepository_CreateTransaction_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - _a2 *entity.Transaction
-func (_e *MockTransactionRepository_Expecter) CreateTransaction(ctx interface{}, tx interface{},
-	_a2 interface{}) *MockTransactionRepository_CreateTransaction_Call {
+func (_e *MockTransactionRepository_Expecter) CreateTransaction(ctx interface{}, tx interface{}, _a2 interface{}) *MockTransactionRepository_CreateTransaction_Call {
 	return &MockTransactionRepository_CreateTransaction_Call{Call: _e.mock.On("CreateTransaction", ctx, tx, _a2)}
 }
 
-func (_c *MockTransactionRepository_CreateTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	_a2 *entity.Transaction)) *MockTransactionRepository_CreateTransaction_Call {
+func (_c *MockTransactionRepository_CreateTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB, _a2 *entity.Transaction)) *MockTransactionRepository_CreateTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Transaction))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_CreateTransaction_Call) Return(_a0 *entity.Transaction,
-	_a1 error) *MockTransactionRepository_CreateTransaction_Call {
+func (_c *MockTransactionRepository_CreateTransaction_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionRepository_CreateTransaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_CreateTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Transaction) (*entity.Transaction, error)) *MockTransactionRepository_CreateTransaction_Call {
+func (_c *MockTransactionRepository_CreateTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Transaction) (*entity.Transaction, error)) *MockTransactionRepository_CreateTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -116,13 +297,11 @@ type MockTransactionRepository_DeleteTransaction_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - id uint
-func (_e *MockTransactionRepository_Expecter) DeleteTransaction(ctx interface{}, tx interface{},
-	id interface{}) *MockTransactionRepository_DeleteTransaction_Call {
+func (_e *MockTransactionRepository_Expecter) DeleteTransaction(ctx interface{}, tx interface{}, id interface{}) *MockTransactionRepository_DeleteTransaction_Call {
 	return &MockTransactionRepository_DeleteTransaction_Call{Call: _e.mock.On("DeleteTransaction", ctx, tx, id)}
 }
 
-func (_c *MockTransactionRepository_DeleteTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	id uint)) *MockTransactionRepository_DeleteTransaction_Call {
+func (_c *MockTransactionRepository_DeleteTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB, id uint)) *MockTransactionRepository_DeleteTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(uint))
 	})
@@ -134,15 +313,13 @@ func (_c *MockTransactionRepository_DeleteTransaction_Call) Return(_a0 error) *M
 	return _c
 }
 
-func (_c *MockTransactionRepository_DeleteTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	uint) error) *MockTransactionRepository_DeleteTransaction_Call {
+func (_c *MockTransactionRepository_DeleteTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB, uint) error) *MockTransactionRepository_DeleteTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetTransactions provides a mock function with given fields: ctx, filters
-func (_m *MockTransactionRepository) GetTransactions(ctx context.Context,
-	filters entity.Filters) ([]*entity.Transaction, error) {
+func (_m *MockTransactionRepository) GetTransactions(ctx context.Context, filters entity.Filters) ([]*entity.Transaction, error) {
 	ret := _m.Called(ctx, filters)
 
 	if len(ret) == 0 {
@@ -178,28 +355,24 @@ type MockTransactionRepository_GetTransactions_Call struct {
 
 // GetTransactions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters transaction.Filters
-func (_e *MockTransactionRepository_Expecter) GetTransactions(ctx interface{},
-	filters interface{}) *MockTransactionRepository_GetTransactions_Call {
+//   - filters entity.Filters
+func (_e *MockTransactionRepository_Expecter) GetTransactions(ctx interface{}, filters interface{}) *MockTransactionRepository_GetTransactions_Call {
 	return &MockTransactionRepository_GetTransactions_Call{Call: _e.mock.On("GetTransactions", ctx, filters)}
 }
 
-func (_c *MockTransactionRepository_GetTransactions_Call) Run(run func(ctx context.Context,
-	filters entity.Filters)) *MockTransactionRepository_GetTransactions_Call {
+func (_c *MockTransactionRepository_GetTransactions_Call) Run(run func(ctx context.Context, filters entity.Filters)) *MockTransactionRepository_GetTransactions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(entity.Filters))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTransactions_Call) Return(_a0 []*entity.Transaction,
-	_a1 error) *MockTransactionRepository_GetTransactions_Call {
+func (_c *MockTransactionRepository_GetTransactions_Call) Return(_a0 []*entity.Transaction, _a1 error) *MockTransactionRepository_GetTransactions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTransactions_Call) RunAndReturn(run func(context.Context,
-	entity.Filters) ([]*entity.Transaction, error)) *MockTransactionRepository_GetTransactions_Call {
+func (_c *MockTransactionRepository_GetTransactions_Call) RunAndReturn(run func(context.Context, entity.Filters) ([]*entity.Transaction, error)) *MockTransactionRepository_GetTransactions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -230,13 +403,11 @@ type MockTransactionRepository_InTransaction_Call struct {
 // InTransaction is a helper method to define mock.On call
 //   - ctx context.Context
 //   - fn func(*gorm.DB) error
-func (_e *MockTransactionRepository_Expecter) InTransaction(ctx interface{},
-	fn interface{}) *MockTransactionRepository_InTransaction_Call {
+func (_e *MockTransactionRepository_Expecter) InTransaction(ctx interface{}, fn interface{}) *MockTransactionRepository_InTransaction_Call {
 	return &MockTransactionRepository_InTransaction_Call{Call: _e.mock.On("InTransaction", ctx, fn)}
 }
 
-func (_c *MockTransactionRepository_InTransaction_Call) Run(run func(ctx context.Context,
-	fn func(*gorm.DB) error)) *MockTransactionRepository_InTransaction_Call {
+func (_c *MockTransactionRepository_InTransaction_Call) Run(run func(ctx context.Context, fn func(*gorm.DB) error)) *MockTransactionRepository_InTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(func(*gorm.DB) error))
 	})
@@ -248,15 +419,173 @@ func (_c *MockTransactionRepository_InTransaction_Call) Return(_a0 error) *MockT
 	return _c
 }
 
-func (_c *MockTransactionRepository_InTransaction_Call) RunAndReturn(run func(context.Context,
-	func(*gorm.DB) error) error) *MockTransactionRepository_InTransaction_Call {
+func (_c *MockTransactionRepository_InTransaction_Call) RunAndReturn(run func(context.Context, func(*gorm.DB) error) error) *MockTransactionRepository_InTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTransaction provides a mock function with given fields: ctx, tx, id
+func (_m *MockTransactionRepository) LockTransaction(ctx context.Context, tx *gorm.DB, id uint) (*entity.Transaction, error) {
+	ret := _m.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTransaction")
+	}
+
+	var r0 *entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) (*entity.Transaction, error)); ok {
+		return rf(ctx, tx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) *entity.Transaction); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, uint) error); ok {
+		r1 = rf(ctx, tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_LockTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTransaction'
+type MockTransactionRepository_LockTransaction_Call struct {
+	*mock.Call
+}
+
+// LockTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - id uint
+func (_e *MockTransactionRepository_Expecter) LockTransaction(ctx interface{}, tx interface{}, id interface{}) *MockTransactionRepository_LockTransaction_Call {
+	return &MockTransactionRepository_LockTransaction_Call{Call: _e.mock.On("LockTransaction", ctx, tx, id)}
+}
+
+func (_c *MockTransactionRepository_LockTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB, id uint)) *MockTransactionRepository_LockTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_LockTransaction_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionRepository_LockTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_LockTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB, uint) (*entity.Transaction, error)) *MockTransactionRepository_LockTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateClaimedBatch provides a mock function with given fields: ctx, tx, batch, owner, now
+func (_m *MockTransactionRepository) UpdateClaimedBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch, owner string, now time.Time) error {
+	ret := _m.Called(ctx, tx, batch, owner, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClaimedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Batch, string, time.Time) error); ok {
+		r0 = rf(ctx, tx, batch, owner, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_UpdateClaimedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaimedBatch'
+type MockTransactionRepository_UpdateClaimedBatch_Call struct {
+	*mock.Call
+}
+
+// UpdateClaimedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - batch *entity.Batch
+//   - owner string
+//   - now time.Time
+func (_e *MockTransactionRepository_Expecter) UpdateClaimedBatch(ctx interface{}, tx interface{}, batch interface{}, owner interface{}, now interface{}) *MockTransactionRepository_UpdateClaimedBatch_Call {
+	return &MockTransactionRepository_UpdateClaimedBatch_Call{Call: _e.mock.On("UpdateClaimedBatch", ctx, tx, batch, owner, now)}
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedBatch_Call) Run(run func(ctx context.Context, tx *gorm.DB, batch *entity.Batch, owner string, now time.Time)) *MockTransactionRepository_UpdateClaimedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Batch), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedBatch_Call) Return(_a0 error) *MockTransactionRepository_UpdateClaimedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedBatch_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Batch, string, time.Time) error) *MockTransactionRepository_UpdateClaimedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateClaimedTransaction provides a mock function with given fields: ctx, tx, item, owner, now
+func (_m *MockTransactionRepository) UpdateClaimedTransaction(ctx context.Context, tx *gorm.DB, item *entity.Transaction, owner string, now time.Time) error {
+	ret := _m.Called(ctx, tx, item, owner, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClaimedTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *entity.Transaction, string, time.Time) error); ok {
+		r0 = rf(ctx, tx, item, owner, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_UpdateClaimedTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaimedTransaction'
+type MockTransactionRepository_UpdateClaimedTransaction_Call struct {
+	*mock.Call
+}
+
+// UpdateClaimedTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *gorm.DB
+//   - item *entity.Transaction
+//   - owner string
+//   - now time.Time
+func (_e *MockTransactionRepository_Expecter) UpdateClaimedTransaction(ctx interface{}, tx interface{}, item interface{}, owner interface{}, now interface{}) *MockTransactionRepository_UpdateClaimedTransaction_Call {
+	return &MockTransactionRepository_UpdateClaimedTransaction_Call{Call: _e.mock.On("UpdateClaimedTransaction", ctx, tx, item, owner, now)}
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB, item *entity.Transaction, owner string, now time.Time)) *MockTransactionRepository_UpdateClaimedTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Transaction), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedTransaction_Call) Return(_a0 error) *MockTransactionRepository_UpdateClaimedTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateClaimedTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Transaction, string, time.Time) error) *MockTransactionRepository_UpdateClaimedTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateTransaction provides a mock function with given fields: ctx, tx, item
-func (_m *MockTransactionRepository) UpdateTransaction(ctx context.Context, tx *gorm.DB,
-	item *entity.Transaction) error {
+func (_m *MockTransactionRepository) UpdateTransaction(ctx context.Context, tx *gorm.DB, item *entity.Transaction) error {
 	ret := _m.Called(ctx, tx, item)
 
 	if len(ret) == 0 {
@@ -282,13 +611,11 @@ type MockTransactionRepository_UpdateTransaction_Call struct {
 //   - ctx context.Context
 //   - tx *gorm.DB
 //   - item *entity.Transaction
-func (_e *MockTransactionRepository_Expecter) UpdateTransaction(ctx interface{}, tx interface{},
-	item interface{}) *MockTransactionRepository_UpdateTransaction_Call {
+func (_e *MockTransactionRepository_Expecter) UpdateTransaction(ctx interface{}, tx interface{}, item interface{}) *MockTransactionRepository_UpdateTransaction_Call {
 	return &MockTransactionRepository_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", ctx, tx, item)}
 }
 
-func (_c *MockTransactionRepository_UpdateTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB,
-	item *entity.Transaction)) *MockTransactionRepository_UpdateTransaction_Call {
+func (_c *MockTransactionRepository_UpdateTransaction_Call) Run(run func(ctx context.Context, tx *gorm.DB, item *entity.Transaction)) *MockTransactionRepository_UpdateTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*gorm.DB), args[2].(*entity.Transaction))
 	})
@@ -300,8 +627,7 @@ func (_c *MockTransactionRepository_UpdateTransaction_Call) Return(_a0 error) *M
 	return _c
 }
 
-func (_c *MockTransactionRepository_UpdateTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB,
-	*entity.Transaction) error) *MockTransactionRepository_UpdateTransaction_Call {
+func (_c *MockTransactionRepository_UpdateTransaction_Call) RunAndReturn(run func(context.Context, *gorm.DB, *entity.Transaction) error) *MockTransactionRepository_UpdateTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,7 +5,10 @@ package transactionmock
 import (
 	context "context"
 
+	common "github.com/safayildirim/asset-management-service/internal/common"
+
 	entity "github.com/safayildirim/asset-management-service/internal/transaction/entity"
+
 	mock "github.com/stretchr/testify/mock"
 
 	request "github.com/safayildirim/asset-management-service/internal/transaction/request"
@@ -24,6 +27,66 @@ func (_m *MockTransactionService) EXPECT() *MockTransactionService_Expecter {
 	return &MockTransactionService_Expecter{mock: &_m.Mock}
 }
 
+// AmendTransaction provides a mock function with given fields: ctx, id, _a2
+func (_m *MockTransactionService) AmendTransaction(ctx context.Context, id uint, _a2 *request.AmendTransactionRequest) (*entity.Transaction, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendTransaction")
+	}
+
+	var r0 *entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.AmendTransactionRequest) (*entity.Transaction, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.AmendTransactionRequest) *entity.Transaction); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *request.AmendTransactionRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_AmendTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendTransaction'
+type MockTransactionService_AmendTransaction_Call struct {
+	*mock.Call
+}
+
+// AmendTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - _a2 *request.AmendTransactionRequest
+func (_e *MockTransactionService_Expecter) AmendTransaction(ctx interface{}, id interface{}, _a2 interface{}) *MockTransactionService_AmendTransaction_Call {
+	return &MockTransactionService_AmendTransaction_Call{Call: _e.mock.On("AmendTransaction", ctx, id, _a2)}
+}
+
+func (_c *MockTransactionService_AmendTransaction_Call) Run(run func(ctx context.Context, id uint, _a2 *request.AmendTransactionRequest)) *MockTransactionService_AmendTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*request.AmendTransactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_AmendTransaction_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionService_AmendTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_AmendTransaction_Call) RunAndReturn(run func(context.Context, uint, *request.AmendTransactionRequest) (*entity.Transaction, error)) *MockTransactionService_AmendTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelTransaction provides a mock function with given fields: ctx, id
 func (_m *MockTransactionService) CancelTransaction(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
@@ -71,24 +134,83 @@ func (_c *MockTransactionService_CancelTransaction_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetTransaction provides a mock function with given fields: ctx, id
+func (_m *MockTransactionService) GetTransaction(ctx context.Context, id uint) (*entity.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entity.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entity.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockTransactionService_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockTransactionService_Expecter) GetTransaction(ctx interface{}, id interface{}) *MockTransactionService_GetTransaction_Call {
+	return &MockTransactionService_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, id)}
+}
+
+func (_c *MockTransactionService_GetTransaction_Call) Run(run func(ctx context.Context, id uint)) *MockTransactionService_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_GetTransaction_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionService_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_GetTransaction_Call) RunAndReturn(run func(context.Context, uint) (*entity.Transaction, error)) *MockTransactionService_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransactions provides a mock function with given fields: ctx, _a1
-func (_m *MockTransactionService) GetTransactions(ctx context.Context, _a1 *request.GetTransactionsParams) ([]*entity.Transaction, error) {
+func (_m *MockTransactionService) GetTransactions(ctx context.Context, _a1 *request.GetTransactionsParams) (*common.Page[*entity.Transaction], error) {
 	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactions")
 	}
 
-	var r0 []*entity.Transaction
+	var r0 *common.Page[*entity.Transaction]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *request.GetTransactionsParams) ([]*entity.Transaction, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetTransactionsParams) (*common.Page[*entity.Transaction], error)); ok {
 		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *request.GetTransactionsParams) []*entity.Transaction); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetTransactionsParams) *common.Page[*entity.Transaction]); ok {
 		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entity.Transaction)
+			r0 = ret.Get(0).(*common.Page[*entity.Transaction])
 		}
 	}
 
@@ -120,12 +242,72 @@ func (_c *MockTransactionService_GetTransactions_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockTransactionService_GetTransactions_Call) Return(_a0 []*entity.Transaction, _a1 error) *MockTransactionService_GetTransactions_Call {
+func (_c *MockTransactionService_GetTransactions_Call) Return(_a0 *common.Page[*entity.Transaction], _a1 error) *MockTransactionService_GetTransactions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionService_GetTransactions_Call) RunAndReturn(run func(context.Context, *request.GetTransactionsParams) ([]*entity.Transaction, error)) *MockTransactionService_GetTransactions_Call {
+func (_c *MockTransactionService_GetTransactions_Call) RunAndReturn(run func(context.Context, *request.GetTransactionsParams) (*common.Page[*entity.Transaction], error)) *MockTransactionService_GetTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReverseTransaction provides a mock function with given fields: ctx, id, _a2
+func (_m *MockTransactionService) ReverseTransaction(ctx context.Context, id uint, _a2 *request.ReverseTransactionRequest) (*entity.Transaction, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReverseTransaction")
+	}
+
+	var r0 *entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.ReverseTransactionRequest) (*entity.Transaction, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.ReverseTransactionRequest) *entity.Transaction); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *request.ReverseTransactionRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_ReverseTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransaction'
+type MockTransactionService_ReverseTransaction_Call struct {
+	*mock.Call
+}
+
+// ReverseTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - _a2 *request.ReverseTransactionRequest
+func (_e *MockTransactionService_Expecter) ReverseTransaction(ctx interface{}, id interface{}, _a2 interface{}) *MockTransactionService_ReverseTransaction_Call {
+	return &MockTransactionService_ReverseTransaction_Call{Call: _e.mock.On("ReverseTransaction", ctx, id, _a2)}
+}
+
+func (_c *MockTransactionService_ReverseTransaction_Call) Run(run func(ctx context.Context, id uint, _a2 *request.ReverseTransactionRequest)) *MockTransactionService_ReverseTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*request.ReverseTransactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_ReverseTransaction_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionService_ReverseTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_ReverseTransaction_Call) RunAndReturn(run func(context.Context, uint, *request.ReverseTransactionRequest) (*entity.Transaction, error)) *MockTransactionService_ReverseTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -189,6 +371,124 @@ func (_c *MockTransactionService_ScheduleTransaction_Call) RunAndReturn(run func
 	return _c
 }
 
+// Transfer provides a mock function with given fields: ctx, _a1
+func (_m *MockTransactionService) Transfer(ctx context.Context, _a1 *request.TransferRequest) (*entity.Transaction, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 *entity.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.TransferRequest) (*entity.Transaction, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.TransferRequest) *entity.Transaction); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.TransferRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_Transfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transfer'
+type MockTransactionService_Transfer_Call struct {
+	*mock.Call
+}
+
+// Transfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.TransferRequest
+func (_e *MockTransactionService_Expecter) Transfer(ctx interface{}, _a1 interface{}) *MockTransactionService_Transfer_Call {
+	return &MockTransactionService_Transfer_Call{Call: _e.mock.On("Transfer", ctx, _a1)}
+}
+
+func (_c *MockTransactionService_Transfer_Call) Run(run func(ctx context.Context, _a1 *request.TransferRequest)) *MockTransactionService_Transfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.TransferRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_Transfer_Call) Return(_a0 *entity.Transaction, _a1 error) *MockTransactionService_Transfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_Transfer_Call) RunAndReturn(run func(context.Context, *request.TransferRequest) (*entity.Transaction, error)) *MockTransactionService_Transfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferBatch provides a mock function with given fields: ctx, _a1
+func (_m *MockTransactionService) TransferBatch(ctx context.Context, _a1 *request.BatchTransferRequest) (*entity.Batch, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferBatch")
+	}
+
+	var r0 *entity.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.BatchTransferRequest) (*entity.Batch, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.BatchTransferRequest) *entity.Batch); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.BatchTransferRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_TransferBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferBatch'
+type MockTransactionService_TransferBatch_Call struct {
+	*mock.Call
+}
+
+// TransferBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.BatchTransferRequest
+func (_e *MockTransactionService_Expecter) TransferBatch(ctx interface{}, _a1 interface{}) *MockTransactionService_TransferBatch_Call {
+	return &MockTransactionService_TransferBatch_Call{Call: _e.mock.On("TransferBatch", ctx, _a1)}
+}
+
+func (_c *MockTransactionService_TransferBatch_Call) Run(run func(ctx context.Context, _a1 *request.BatchTransferRequest)) *MockTransactionService_TransferBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.BatchTransferRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_TransferBatch_Call) Return(_a0 *entity.Batch, _a1 error) *MockTransactionService_TransferBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_TransferBatch_Call) RunAndReturn(run func(context.Context, *request.BatchTransferRequest) (*entity.Batch, error)) *MockTransactionService_TransferBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTransactionService creates a new instance of MockTransactionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTransactionService(t interface {
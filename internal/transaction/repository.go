@@ -2,15 +2,37 @@ package transaction
 
 import (
 	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
 	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"gopkg.in/guregu/null.v3"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
+// sortColumns maps the fields transactions can be sorted by to their columns
+var sortColumns = map[string]common.SortColumn{
+	"id":           {Name: "id"},
+	"created_at":   {Name: "created_at", Type: "timestamp"},
+	"scheduled_at": {Name: "scheduled_at", Type: "timestamp"},
+	"amount":       {Name: "amount", Type: "numeric"},
+}
+
 type Repository interface {
 	CreateTransaction(ctx context.Context, tx *gorm.DB, entity *entity.Transaction) (*entity.Transaction, error)
 	GetTransactions(ctx context.Context, filters entity.Filters) ([]*entity.Transaction, error)
+	LockTransaction(ctx context.Context, tx *gorm.DB, id uint) (*entity.Transaction, error)
 	DeleteTransaction(ctx context.Context, tx *gorm.DB, id uint) error
 	UpdateTransaction(ctx context.Context, tx *gorm.DB, item *entity.Transaction) error
+	ClaimDueTransactions(ctx context.Context, owner string, now time.Time, lease time.Duration,
+		limit int) ([]*entity.Transaction, error)
+	UpdateClaimedTransaction(ctx context.Context, tx *gorm.DB, item *entity.Transaction, owner string,
+		now time.Time) error
+	CreateBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch) (*entity.Batch, error)
+	ClaimDueBatches(ctx context.Context, owner string, now time.Time, lease time.Duration,
+		limit int) ([]*entity.Batch, error)
+	UpdateClaimedBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch, owner string, now time.Time) error
 	InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error
 }
 
@@ -53,12 +75,36 @@ func (r *repository) GetTransactions(ctx context.Context, filters entity.Filters
 	if len(filters.Status) > 0 {
 		query = query.Where("status IN ?", filters.Status)
 	}
+	if len(filters.RecurringScheduleID) > 0 {
+		query = query.Where("recurring_schedule_id IN ?", filters.RecurringScheduleID)
+	}
+	if len(filters.BatchID) > 0 {
+		query = query.Where("batch_id IN ?", filters.BatchID)
+	}
 	if !filters.ScheduledStart.IsZero() {
 		query = query.Where("scheduled_at >= ?", filters.ScheduledStart)
 	}
 	if !filters.ScheduledEnd.IsZero() {
 		query = query.Where("scheduled_at <= ?", filters.ScheduledEnd)
 	}
+	if len(filters.AssetName) > 0 {
+		query = query.Where("asset_name IN ?", filters.AssetName)
+	}
+	if filters.MinAmount != nil {
+		query = query.Where("amount >= ?", *filters.MinAmount)
+	}
+	if filters.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filters.MaxAmount)
+	}
+	if !filters.CreatedStart.IsZero() {
+		query = query.Where("created_at >= ?", filters.CreatedStart)
+	}
+	if !filters.CreatedEnd.IsZero() {
+		query = query.Where("created_at <= ?", filters.CreatedEnd)
+	}
+	if filters.Page != nil {
+		query = common.Paginate(query, filters.Page, sortColumns)
+	}
 
 	err := query.Find(&transactions).Error
 	if err != nil {
@@ -68,6 +114,23 @@ func (r *repository) GetTransactions(ctx context.Context, filters entity.Filters
 	return transactions, nil
 }
 
+// LockTransaction fetches a transaction and locks its row until the surrounding database transaction ends, so that it
+// cannot be claimed or changed concurrently. ErrTransactionNotFound is returned if the transaction does not exist.
+func (r *repository) LockTransaction(ctx context.Context, tx *gorm.DB, id uint) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Take(&transaction, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
 func (r *repository) DeleteTransaction(ctx context.Context, tx *gorm.DB, id uint) error {
 	db := tx
 	if db == nil {
@@ -94,6 +157,136 @@ func (r *repository) UpdateTransaction(ctx context.Context, tx *gorm.DB, item *e
 	return nil
 }
 
+// ClaimDueTransactions leases up to limit pending transactions that are due at the given time to the given owner.
+// Transactions waiting for a retry are only due once their next attempt time has been reached. The legs of batch
+// transfers are never claimed on their own, they are executed together with their batch.
+//
+// Rows that are unclaimed, or whose previous lease has expired (e.g. because the scheduler instance holding it
+// crashed), are claimed in a single statement. Rows locked by a concurrent claim are skipped instead of waited on, so
+// any number of scheduler instances can claim work at the same time without receiving the same row.
+func (r *repository) ClaimDueTransactions(ctx context.Context, owner string, now time.Time, lease time.Duration,
+	limit int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE scheduled_transactions
+		SET claimed_by = ?, claim_expires_at = ?
+		WHERE id IN (
+			SELECT id FROM scheduled_transactions
+			WHERE status = ? AND scheduled_at <= ? AND (claim_expires_at IS NULL OR claim_expires_at <= ?)
+				AND (next_attempt_at IS NULL OR next_attempt_at <= ?) AND batch_id IS NULL
+			ORDER BY scheduled_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		owner, now.Add(lease), entity.TransactionPending, now, now, now, limit).Scan(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// UpdateClaimedTransaction saves a claimed transaction and releases its claim, provided the given owner still holds an
+// unexpired lease on it. ErrClaimLost is returned otherwise, in which case the surrounding database transaction must
+// be rolled back because another instance may be processing the row.
+func (r *repository) UpdateClaimedTransaction(ctx context.Context, tx *gorm.DB, item *entity.Transaction,
+	owner string, now time.Time) error {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+
+	item.ClaimedBy = null.String{}
+	item.ClaimExpiresAt = null.Time{}
+	item.UpdatedAt = null.TimeFrom(now)
+
+	result := db.WithContext(ctx).Model(item).Where("claimed_by = ? AND claim_expires_at > ?", owner, now).
+		Select("*").Omit("id", "created_at").Updates(item)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrClaimLost
+	}
+
+	return nil
+}
+
+func (r *repository) CreateBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch) (*entity.Batch, error) {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	err := db.WithContext(ctx).Create(batch).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// ClaimDueBatches leases up to limit pending batch transfers that are due at the given time to the given owner, using
+// the same semantics as ClaimDueTransactions. The legs of every claimed batch are loaded in ID order.
+func (r *repository) ClaimDueBatches(ctx context.Context, owner string, now time.Time, lease time.Duration,
+	limit int) ([]*entity.Batch, error) {
+	var batches []*entity.Batch
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE transfer_batches
+		SET claimed_by = ?, claim_expires_at = ?
+		WHERE id IN (
+			SELECT id FROM transfer_batches
+			WHERE status = ? AND scheduled_at <= ? AND (claim_expires_at IS NULL OR claim_expires_at <= ?)
+				AND (next_attempt_at IS NULL OR next_attempt_at <= ?)
+			ORDER BY scheduled_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		owner, now.Add(lease), entity.TransactionPending, now, now, now, limit).Scan(&batches).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range batches {
+		err = r.db.WithContext(ctx).Where("batch_id = ?", batch.ID).Order("id").Find(&batch.Legs).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return batches, nil
+}
+
+// UpdateClaimedBatch saves a claimed batch and releases its claim, provided the given owner still holds an unexpired
+// lease on it. ErrClaimLost is returned otherwise. The legs of the batch are not saved.
+func (r *repository) UpdateClaimedBatch(ctx context.Context, tx *gorm.DB, batch *entity.Batch, owner string,
+	now time.Time) error {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+
+	batch.ClaimedBy = null.String{}
+	batch.ClaimExpiresAt = null.Time{}
+	batch.UpdatedAt = null.TimeFrom(now)
+
+	result := db.WithContext(ctx).Model(batch).Where("claimed_by = ? AND claim_expires_at > ?", owner, now).
+		Select("*").Omit("id", "created_at").Updates(batch)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrClaimLost
+	}
+
+	return nil
+}
+
 func (r *repository) InTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
 	tx := r.db.WithContext(ctx).Begin() // Start a transaction
 	if tx.Error != nil {
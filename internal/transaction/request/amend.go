@@ -0,0 +1,39 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// AmendTransactionRequest changes a pending transaction; fields that are omitted are left unchanged
+type AmendTransactionRequest struct {
+	DestinationWalletID *uint            `json:"destination_wallet_id"`
+	Amount              *decimal.Decimal `json:"amount"`
+	ScheduledAt         *time.Time       `json:"scheduled_at"`
+}
+
+func (r AmendTransactionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.DestinationWalletID, validation.By(func(value interface{}) error {
+			if r.DestinationWalletID == nil && r.Amount == nil && r.ScheduledAt == nil {
+				return errors.New("at least one of destination_wallet_id, amount or scheduled_at is required")
+			}
+			if r.DestinationWalletID != nil && *r.DestinationWalletID == 0 {
+				return errors.New("cannot be blank")
+			}
+			return nil
+		})),
+		validation.Field(&r.Amount, common.PositiveAmount),
+		validation.Field(&r.ScheduledAt, validation.By(func(value interface{}) error {
+			if r.ScheduledAt != nil && r.ScheduledAt.IsZero() {
+				return errors.New("cannot be blank")
+			}
+			return nil
+		})),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "amend transaction validation error")
+}
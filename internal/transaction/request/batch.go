@@ -0,0 +1,42 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// MaxBatchLegs is the largest number of transfers accepted in a single batch
+const MaxBatchLegs = 1000
+
+type BatchTransferRequest struct {
+	Legs        []BatchTransferLeg `json:"legs"`
+	ScheduledAt *time.Time         `json:"scheduled_at"`
+}
+
+type BatchTransferLeg struct {
+	SourceWalletID      uint            `json:"source_wallet_id"`
+	DestinationWalletID uint            `json:"destination_wallet_id"`
+	AssetName           string          `json:"asset_name"`
+	Amount              decimal.Decimal `json:"amount"`
+}
+
+func (r BatchTransferRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Legs, validation.Required, validation.Length(1, MaxBatchLegs)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "batch transfer create validation error")
+}
+
+func (l BatchTransferLeg) Validate() error {
+	return validation.ValidateStruct(&l,
+		validation.Field(&l.SourceWalletID, validation.Required),
+		validation.Field(&l.DestinationWalletID, validation.Required,
+			validation.NotIn(l.SourceWalletID).Error("must be different from source_wallet_id")),
+		validation.Field(&l.AssetName, validation.Required),
+		validation.Field(&l.Amount, common.PositiveAmount),
+	)
+}
@@ -3,15 +3,17 @@ package request
 import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
 	"time"
 )
 
 type ScheduleTransactionRequest struct {
-	SourceWalletID      uint      `json:"source_wallet_id"`
-	DestinationWalletID uint      `json:"destination_wallet_id"`
-	AssetName           string    `json:"asset_name"`
-	Amount              float64   `json:"amount"`
-	ScheduledAt         time.Time `json:"scheduled_at"`
+	SourceWalletID      uint            `json:"source_wallet_id"`
+	DestinationWalletID uint            `json:"destination_wallet_id"`
+	AssetName           string          `json:"asset_name"`
+	Amount              decimal.Decimal `json:"amount"`
+	ScheduledAt         time.Time       `json:"scheduled_at"`
 }
 
 func (r ScheduleTransactionRequest) Validate() error {
@@ -19,7 +21,7 @@ func (r ScheduleTransactionRequest) Validate() error {
 		validation.Field(&r.SourceWalletID, validation.Required),
 		validation.Field(&r.DestinationWalletID, validation.Required),
 		validation.Field(&r.AssetName, validation.Required),
-		validation.Field(&r.Amount, validation.Required),
+		validation.Field(&r.Amount, common.PositiveAmount),
 		validation.Field(&r.ScheduledAt, validation.Required),
 	}
 
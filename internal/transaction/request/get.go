@@ -3,13 +3,31 @@ package request
 import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+	"time"
 )
 
+// SortFields lists the fields transactions can be sorted by
+var SortFields = []string{"id", "created_at", "scheduled_at", "amount"}
+
 type GetTransactionsParams struct {
-	ID                  []uint   `json:"id" schema:"id"`
-	SourceWalletID      []uint   `json:"source_wallet_id" schema:"source_wallet_id"`
-	DestinationWalletID []uint   `json:"destination_wallet_id" schema:"destination_wallet_id"`
-	Status              []string `json:"status" schema:"status"`
+	ID                  []uint           `json:"id" schema:"id"`
+	SourceWalletID      []uint           `json:"source_wallet_id" schema:"source_wallet_id"`
+	DestinationWalletID []uint           `json:"destination_wallet_id" schema:"destination_wallet_id"`
+	Status              []string         `json:"status" schema:"status"`
+	RecurringScheduleID []uint           `json:"recurring_schedule_id" schema:"recurring_schedule_id"`
+	BatchID             []uint           `json:"batch_id" schema:"batch_id"`
+	AssetName           []string         `json:"asset_name" schema:"asset_name"`
+	MinAmount           *decimal.Decimal `json:"min_amount" schema:"min_amount"`
+	MaxAmount           *decimal.Decimal `json:"max_amount" schema:"max_amount"`
+	ScheduledStart      time.Time        `json:"scheduled_start" schema:"scheduled_start"`
+	ScheduledEnd        time.Time        `json:"scheduled_end" schema:"scheduled_end"`
+	CreatedStart        time.Time        `json:"created_start" schema:"created_start"`
+	CreatedEnd          time.Time        `json:"created_end" schema:"created_end"`
+	Limit               int              `json:"limit" schema:"limit"`
+	Sort                string           `json:"sort" schema:"sort"`
+	Cursor              string           `json:"cursor" schema:"cursor"`
 }
 
 func (r GetTransactionsParams) Validate() error {
@@ -20,12 +38,40 @@ func (r GetTransactionsParams) Validate() error {
 			}
 
 			for _, v := range value.([]string) {
-				if v != "pending" && v != "completed" && v != "cancelled" && v != "failed" {
+				if v != "pending" && v != "completed" && v != "cancelled" && v != "failed" && v != "reversed" {
 					return errors.New("invalid status")
 				}
 			}
 			return nil
 		})),
+		validation.Field(&r.MinAmount, common.NonNegativeAmount),
+		validation.Field(&r.MaxAmount, common.NonNegativeAmount, validation.By(func(value interface{}) error {
+			if r.MinAmount != nil && r.MaxAmount != nil && r.MaxAmount.LessThan(*r.MinAmount) {
+				return errors.New("must not be lower than min_amount")
+			}
+			return nil
+		})),
+		validation.Field(&r.ScheduledEnd, validation.By(func(value interface{}) error {
+			if !r.ScheduledStart.IsZero() && !r.ScheduledEnd.IsZero() && r.ScheduledEnd.Before(r.ScheduledStart) {
+				return errors.New("must not be before scheduled_start")
+			}
+			return nil
+		})),
+		validation.Field(&r.CreatedEnd, validation.By(func(value interface{}) error {
+			if !r.CreatedStart.IsZero() && !r.CreatedEnd.IsZero() && r.CreatedEnd.Before(r.CreatedStart) {
+				return errors.New("must not be before created_start")
+			}
+			return nil
+		})),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(common.MaxPageLimit)),
+		validation.Field(&r.Sort, common.SortRule(SortFields...)),
+		validation.Field(&r.Cursor, validation.By(func(value interface{}) error {
+			if r.Cursor == "" {
+				return nil
+			}
+			_, err := common.DecodeCursor(r.Cursor, common.ParseSort(r.Sort))
+			return err
+		})),
 	}
 
 	return errors.Wrap(validation.ValidateStruct(&r, fields...), "schedule transaction create validation error")
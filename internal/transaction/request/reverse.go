@@ -0,0 +1,23 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+type ReverseTransactionRequest struct {
+	// Amount to give back; the whole amount not reversed yet when omitted
+	Amount *decimal.Decimal `json:"amount"`
+	// AllowPartial reverses whatever the destination wallet still has available instead of failing
+	AllowPartial bool `json:"allow_partial"`
+}
+
+func (r ReverseTransactionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Amount, common.PositiveAmount),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "reverse transaction validation error")
+}
@@ -0,0 +1,27 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+type TransferRequest struct {
+	SourceWalletID      uint            `json:"source_wallet_id"`
+	DestinationWalletID uint            `json:"destination_wallet_id"`
+	AssetName           string          `json:"asset_name"`
+	Amount              decimal.Decimal `json:"amount"`
+}
+
+func (r TransferRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.SourceWalletID, validation.Required),
+		validation.Field(&r.DestinationWalletID, validation.Required,
+			validation.NotIn(r.SourceWalletID).Error("must be different from source_wallet_id")),
+		validation.Field(&r.AssetName, validation.Required),
+		validation.Field(&r.Amount, common.PositiveAmount),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "transfer create validation error")
+}
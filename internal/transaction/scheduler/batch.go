@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/transaction"
+	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"strconv"
+)
+
+// executeBatch moves the funds of every leg of a claimed batch transfer and marks the batch and its legs completed in
+// a single database transaction, so that either all legs are executed or none is. A TransactionCompleted event is
+// written for every leg.
+func (s *Scheduler) executeBatch(ctx context.Context, b *entity.Batch) error {
+	now := common.Now()
+
+	b.Attempts++
+	b.LastAttemptAt = null.TimeFrom(now)
+
+	return s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		for _, leg := range b.Legs {
+			// Every leg is recorded in the ledger against its own transaction
+			reference := ledgerentity.Reference{
+				Type: ledgerentity.ReferenceTransfer,
+				ID:   strconv.FormatUint(uint64(leg.ID), 10),
+			}
+
+			err := s.transfer(ctx, tx, leg, reference)
+			if err != nil {
+				return errors.Wrapf(err, "leg %d", leg.ID)
+			}
+
+			// Work on a copy, so that the holds of the legs stay known if a later leg fails and the batch rolls back
+			completed := *leg
+			completed.Status = entity.TransactionCompleted
+			completed.Held = false
+			completed.Attempts = b.Attempts
+			completed.LastAttemptAt = b.LastAttemptAt
+			completed.UpdatedAt = null.TimeFrom(now)
+			err = s.transactionRepository.UpdateTransaction(ctx, tx, &completed)
+			if err != nil {
+				return err
+			}
+
+			err = outbox.Record(ctx, s.outboxRepository, tx, outboxentity.TransactionCompleted, completed.ID,
+				&completed)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Mark the batch completed, which fails if the lease was lost in the meantime
+		b.Status = entity.TransactionCompleted
+		b.FailureReason = null.String{}
+		b.NextAttemptAt = null.Time{}
+		return s.transactionRepository.UpdateClaimedBatch(ctx, tx, b, s.id, now)
+	})
+}
+
+// failBatch records a failed execution attempt of a claimed batch transfer. Like single transactions, transient
+// failures are retried according to the retry policy. Otherwise the batch and all its legs are marked as failed and
+// the holds of the legs are released, writing a TransactionFailed event for every leg.
+func (s *Scheduler) failBatch(ctx context.Context, b *entity.Batch, cause error) {
+	now := common.Now()
+	reason := transaction.ClassifyFailure(cause)
+	b.FailureReason = null.StringFrom(string(reason))
+
+	if s.retryPolicy.ShouldRetry(reason, b.Attempts) {
+		b.Status = entity.TransactionPending
+		b.NextAttemptAt = null.TimeFrom(now.Add(s.retryPolicy.NextDelay(b.Attempts)))
+	} else {
+		b.Status = entity.TransactionFailed
+		b.NextAttemptAt = null.Time{}
+	}
+
+	err := s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		if b.Status == entity.TransactionFailed {
+			for _, leg := range b.Legs {
+				// Give the funds held for the leg back to its source wallet
+				if leg.Held {
+					_, err := s.assetService.ReleaseHold(ctx, tx, &request.HoldRequest{
+						WalletID: leg.SourceWalletID,
+						Name:     leg.AssetName,
+						Amount:   leg.Amount,
+					})
+					if err != nil {
+						return err
+					}
+					leg.Held = false
+				}
+
+				leg.Status = entity.TransactionFailed
+				leg.FailureReason = b.FailureReason
+				leg.Attempts = b.Attempts
+				leg.LastAttemptAt = b.LastAttemptAt
+				leg.UpdatedAt = null.TimeFrom(now)
+				err := s.transactionRepository.UpdateTransaction(ctx, tx, leg)
+				if err != nil {
+					return err
+				}
+
+				err = outbox.Record(ctx, s.outboxRepository, tx, outboxentity.TransactionFailed, leg.ID, leg)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return s.transactionRepository.UpdateClaimedBatch(ctx, tx, b, s.id, now)
+	})
+	if err != nil {
+		log.Logger.Error("failed to record batch transfer failure", zap.Uint("id", b.ID), zap.Error(err))
+	}
+}
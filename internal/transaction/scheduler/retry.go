@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/internal/worker"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"time"
+)
+
+// RetryPolicy decides whether and when a failed transaction is attempted again
+type RetryPolicy struct {
+	// MaxAttempts is the total number of execution attempts, including the first one
+	MaxAttempts int
+
+	worker.Backoff
+}
+
+// NewRetryPolicy builds the retry policy described by the scheduler configuration
+func NewRetryPolicy(cfg config.SchedulerConfig) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff: worker.NewBackoff(time.Duration(cfg.RetryBaseDelay)*time.Second,
+			time.Duration(cfg.RetryMaxDelay)*time.Second, float64(cfg.RetryJitter)/100),
+	}
+}
+
+// ShouldRetry reports whether a transaction that failed for the given reason after the given number of attempts is
+// attempted again. Only internal errors such as timeouts or an unavailable wallet service, and balances that kept
+// changing concurrently, are transient; business failures like an insufficient balance fail immediately.
+func (p RetryPolicy) ShouldRetry(reason entity.FailureReason, attempts int) bool {
+	return reason.Transient() && attempts < p.MaxAttempts
+}
@@ -2,109 +2,255 @@ package scheduler
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/asset"
 	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/recurring"
 	"github.com/safayildirim/asset-management-service/internal/transaction"
 	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/internal/worker"
 	"github.com/safayildirim/asset-management-service/pkg/config"
 	"github.com/safayildirim/asset-management-service/pkg/log"
 	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
 	"gorm.io/gorm"
+	"strconv"
 	"time"
 )
 
 type Scheduler struct {
+	id                    string
 	cfg                   config.SchedulerConfig
+	retryPolicy           RetryPolicy
 	assetService          asset.Service
+	recurringService      recurring.Service
 	transactionRepository transaction.Repository
+	outboxRepository      outbox.Repository
 }
 
 // NewScheduler initializes a new Scheduler instance.
 //
 // Parameters:
-// - cfg: Configuration for the scheduler, including the interval between runs.
+// - cfg: Configuration for the scheduler, including the interval between runs, the lease duration, the batch size and
+// the retry policy.
 // - assetService: Service to handle asset-related operations such as deposits and withdrawals.
+// - recurringService: Service that materialises the due occurrences of recurring schedules.
 // - transactionRepository: Repository to handle transaction-related database operations.
+// - outboxRepository: Repository the events about completed and failed transactions are written to.
 //
 // Returns:
-// - A pointer to a newly created Scheduler instance.
-func NewScheduler(cfg config.SchedulerConfig, assetService asset.Service,
-	transactionRepository transaction.Repository) *Scheduler {
-	return &Scheduler{cfg: cfg, assetService: assetService, transactionRepository: transactionRepository}
+// - A pointer to a newly created Scheduler instance with a unique identity used to claim transactions.
+func NewScheduler(cfg config.SchedulerConfig, assetService asset.Service, recurringService recurring.Service,
+	transactionRepository transaction.Repository, outboxRepository outbox.Repository) *Scheduler {
+	return &Scheduler{id: worker.NewInstanceID(), cfg: cfg, retryPolicy: NewRetryPolicy(cfg), assetService: assetService,
+		recurringService: recurringService, transactionRepository: transactionRepository,
+		outboxRepository: outboxRepository}
 }
 
-// Start runs the scheduler in an infinite loop to process pending transactions.
+// ID returns the identity this scheduler claims transactions with
+func (s *Scheduler) ID() string {
+	return s.id
+}
+
+// Start runs the scheduler in a loop to process pending transactions until the context is cancelled.
 //
 // Parameters:
 // - ctx: Context for managing request lifecycle and cancellation.
 //
 // Notes:
-// - This function runs indefinitely, using the interval from the configuration for pauses between runs.
-// - Logs errors and skips failed transactions, allowing the scheduler to continue processing others.
+// - Uses the interval from the configuration for pauses between runs.
+// - Logs errors and keeps running, so a temporary database outage does not stop the scheduler.
 func (s *Scheduler) Start(ctx context.Context) {
-	log.Logger.Info("scheduler started")
+	log.Logger.Info("scheduler started", zap.String("id", s.id))
+
+	ticker := time.NewTicker(time.Duration(s.cfg.Interval) * time.Second)
+	defer ticker.Stop()
 
 	for {
-		// Fetch pending transactions scheduled to run before the current time
-		transactions, err := s.transactionRepository.GetTransactions(ctx, entity.Filters{
-			Status:       []string{string(entity.TransactionPending)},
-			ScheduledEnd: time.Now(),
-		})
+		_, err := s.RunOnce(ctx)
 		if err != nil {
-			log.Logger.Error("failed to get transactions", zap.Error(err))
+			log.Logger.Error("failed to run scheduler", zap.Error(err))
+		}
 
+		select {
+		case <-ctx.Done():
+			log.Logger.Info("scheduler stopped", zap.String("id", s.id))
 			return
+		case <-ticker.C:
 		}
+	}
+}
+
+// RunOnce materialises the due occurrences of recurring schedules, then claims a batch of due transactions and
+// executes them, followed by the due batch transfers.
+//
+// Transactions are leased to this scheduler before being executed, so several instances can run concurrently without
+// executing the same transaction twice. A transaction whose lease expires (e.g. because its instance crashed) is
+// claimed again by the next run of any instance.
+//
+// Parameters:
+// - ctx: Context for managing request lifecycle and cancellation.
+//
+// Returns:
+// - The number of transactions completed in this run, including the legs of completed batch transfers.
+// - An error if the due transactions or batch transfers could not be claimed.
+func (s *Scheduler) RunOnce(ctx context.Context) (int, error) {
+	// Turn due occurrences of recurring schedules into pending transactions so they are executed below
+	created, err := s.recurringService.MaterializeDueOccurrences(ctx, common.Now(), s.cfg.BatchSize)
+	if err != nil {
+		log.Logger.Error("failed to materialize recurring schedules", zap.Error(err))
+	} else if created > 0 {
+		log.Logger.Info("materialized recurring schedule occurrences", zap.Int("count", created))
+	}
+
+	// Claim pending transactions scheduled to run before the current time
+	transactions, err := s.transactionRepository.ClaimDueTransactions(ctx, s.id, common.Now(),
+		time.Duration(s.cfg.LeaseDuration)*time.Second, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// Log if no pending transactions are found
+	if len(transactions) == 0 {
+		log.Logger.Info("no pending transactions")
+	}
+
+	completed := 0
+
+	// Process each transaction
+	for _, t := range transactions {
+		err = s.execute(ctx, t)
+		switch {
+		case err == nil:
+			// Log the successful completion of the transaction
+			log.Logger.Info("transaction completed", zap.Uint("id", t.ID))
+			completed++
+		case errors.Is(err, transaction.ErrClaimLost):
+			// Another instance claimed the transaction after the lease expired and is responsible for it now
+			log.Logger.Warn("transaction claim lost", zap.Uint("id", t.ID))
+		default:
+			// Record the failure and continue with the next transaction
+			log.Logger.Error("transaction failed", zap.Uint("id", t.ID), zap.Int("attempts", t.Attempts),
+				zap.Error(err))
+			s.fail(ctx, t, err)
+		}
+	}
+
+	// Claim pending batch transfers and execute all legs of each batch together
+	batches, err := s.transactionRepository.ClaimDueBatches(ctx, s.id, common.Now(),
+		time.Duration(s.cfg.LeaseDuration)*time.Second, s.cfg.BatchSize)
+	if err != nil {
+		return completed, err
+	}
 
-		// Log if no pending transactions are found
-		if len(transactions) == 0 {
-			log.Logger.Info("no pending transactions")
+	for _, b := range batches {
+		err = s.executeBatch(ctx, b)
+		switch {
+		case err == nil:
+			log.Logger.Info("batch transfer completed", zap.Uint("id", b.ID), zap.Int("legs", len(b.Legs)))
+			completed += len(b.Legs)
+		case errors.Is(err, transaction.ErrClaimLost):
+			log.Logger.Warn("batch transfer claim lost", zap.Uint("id", b.ID))
+		default:
+			log.Logger.Error("batch transfer failed", zap.Uint("id", b.ID), zap.Int("attempts", b.Attempts),
+				zap.Error(err))
+			s.failBatch(ctx, b, err)
 		}
+	}
+
+	return completed, nil
+}
+
+// fail records a failed execution attempt of a claimed transaction. Transient failures are scheduled for another
+// attempt according to the retry policy; business failures and exhausted retries mark the transaction as failed with
+// the reason derived from the error that stopped its execution, release its hold and write a TransactionFailed event.
+func (s *Scheduler) fail(ctx context.Context, t *entity.Transaction, cause error) {
+	reason := transaction.ClassifyFailure(cause)
+	t.FailureReason = null.StringFrom(string(reason))
+
+	if s.retryPolicy.ShouldRetry(reason, t.Attempts) {
+		t.Status = entity.TransactionPending
+		t.NextAttemptAt = null.TimeFrom(common.Now().Add(s.retryPolicy.NextDelay(t.Attempts)))
+	} else {
+		t.Status = entity.TransactionFailed
+		t.NextAttemptAt = null.Time{}
+	}
 
-		// Process each transaction
-		for _, t := range transactions {
-			// Run the transaction processing in a database transaction
-			err = s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
-				// Withdraw the specified amount from the source wallet
-				_, err = s.assetService.Withdraw(ctx, tx, &request.CreateWithdrawRequest{
-					WalletID: t.SourceWalletID,
-					Name:     t.AssetName,
-					Amount:   t.Amount,
-				})
-				if err != nil {
-					return err
-				}
-
-				// Deposit the specified amount to the destination wallet
-				_, err = s.assetService.Deposit(ctx, tx, &request.CreateDepositRequest{
-					WalletID: t.DestinationWalletID,
-					Name:     t.AssetName,
-					Amount:   t.Amount,
-				})
-				if err != nil {
-					return err
-				}
-
-				// Update the transaction status to "Completed"
-				t.Status = entity.TransactionCompleted
-				err = s.transactionRepository.UpdateTransaction(ctx, tx, t)
-				if err != nil {
-					return err
-				}
-
-				return nil // Commit the transaction if all operations succeed
+	err := s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		// A transaction that will not be attempted again gives the funds held for it back to the source wallet
+		if t.Status == entity.TransactionFailed && t.Held {
+			_, err := s.assetService.ReleaseHold(ctx, tx, &request.HoldRequest{
+				WalletID: t.SourceWalletID,
+				Name:     t.AssetName,
+				Amount:   t.Amount,
 			})
 			if err != nil {
-				// Log the error for the failed transaction and continue with the next one
-				log.Logger.Error("transaction failed", zap.Error(err))
-				continue
+				return err
 			}
+			t.Held = false
+		}
 
-			// Log the successful completion of the transaction
-			log.Logger.Info("transaction completed", zap.Uint("id", t.ID))
+		err := s.transactionRepository.UpdateClaimedTransaction(ctx, tx, t, s.id, common.Now())
+		if err != nil {
+			return err
 		}
 
-		// Pause the scheduler for the configured interval before running again
-		time.Sleep(time.Duration(s.cfg.Interval) * time.Second) // Run every 10 seconds
+		if t.Status != entity.TransactionFailed {
+			return nil
+		}
+		return outbox.Record(ctx, s.outboxRepository, tx, outboxentity.TransactionFailed, t.ID, t)
+	})
+	if err != nil {
+		log.Logger.Error("failed to record transaction failure", zap.Uint("id", t.ID), zap.Error(err))
 	}
 }
+
+// execute moves the funds of a claimed transaction, marks it completed and writes a TransactionCompleted event in a
+// single database transaction
+func (s *Scheduler) execute(ctx context.Context, t *entity.Transaction) error {
+	// Both legs are recorded in the ledger against the scheduled transaction
+	reference := ledgerentity.Reference{
+		Type: ledgerentity.ReferenceScheduledTransaction,
+		ID:   strconv.FormatUint(uint64(t.ID), 10),
+	}
+
+	t.Attempts++
+	t.LastAttemptAt = null.TimeFrom(common.Now())
+
+	return s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		err := s.transfer(ctx, tx, t, reference)
+		if err != nil {
+			return err
+		}
+
+		// Update the transaction status to "Completed", which fails if the lease was lost in the meantime
+		t.Status = entity.TransactionCompleted
+		t.Held = false
+		t.FailureReason = null.String{}
+		t.NextAttemptAt = null.Time{}
+		err = s.transactionRepository.UpdateClaimedTransaction(ctx, tx, t, s.id, common.Now())
+		if err != nil {
+			return err
+		}
+
+		return outbox.Record(ctx, s.outboxRepository, tx, outboxentity.TransactionCompleted, t.ID, t)
+	})
+}
+
+// transfer moves the amount of a transaction from its source wallet, converting the hold placed at scheduling time,
+// to its destination wallet
+func (s *Scheduler) transfer(ctx context.Context, tx *gorm.DB, t *entity.Transaction,
+	reference ledgerentity.Reference) error {
+	return s.assetService.Transfer(ctx, tx, &request.TransferRequest{
+		SourceWalletID:      t.SourceWalletID,
+		DestinationWalletID: t.DestinationWalletID,
+		Name:                t.AssetName,
+		Amount:              t.Amount,
+		Reference:           reference,
+		FromHold:            t.Held,
+	})
+}
@@ -0,0 +1,765 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset"
+	assetentity "github.com/safayildirim/asset-management-service/internal/asset/entity"
+	"github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/recurring"
+	"github.com/safayildirim/asset-management-service/internal/transaction"
+	"github.com/safayildirim/asset-management-service/internal/transaction/entity"
+	"github.com/safayildirim/asset-management-service/internal/worker"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// outboxRepository lets memoryRepository embed outbox.Repository next to transaction.Repository
+type outboxRepository = outbox.Repository
+
+// memoryRepository is an in-memory transaction.Repository and outbox.Repository that mimics the claiming semantics of
+// the SQL implementation. Balance operations and events staged in a database transaction only become visible when it
+// commits.
+type memoryRepository struct {
+	transaction.Repository
+	outboxRepository
+
+	mu           sync.Mutex
+	transactions map[uint]*entity.Transaction
+	batches      map[uint]*entity.Batch
+	staged       map[*gorm.DB][]string
+	updates      map[*gorm.DB][]*entity.Transaction
+	stagedEvents map[*gorm.DB][]*outboxentity.Event
+	executed     map[string]int
+	events       []*outboxentity.Event
+}
+
+func newMemoryRepository(transactions ...*entity.Transaction) *memoryRepository {
+	r := &memoryRepository{
+		transactions: map[uint]*entity.Transaction{},
+		batches:      map[uint]*entity.Batch{},
+		staged:       map[*gorm.DB][]string{},
+		updates:      map[*gorm.DB][]*entity.Transaction{},
+		stagedEvents: map[*gorm.DB][]*outboxentity.Event{},
+		executed:     map[string]int{},
+	}
+	for _, t := range transactions {
+		r.transactions[t.ID] = t
+	}
+
+	return r
+}
+
+func (r *memoryRepository) ClaimDueTransactions(_ context.Context, owner string, now time.Time,
+	lease time.Duration, limit int) ([]*entity.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []uint
+	for id, t := range r.transactions {
+		if t.Status == entity.TransactionPending && !t.ScheduledAt.After(now) &&
+			(!t.ClaimExpiresAt.Valid || !t.ClaimExpiresAt.Time.After(now)) &&
+			(!t.NextAttemptAt.Valid || !t.NextAttemptAt.Time.After(now)) && !t.BatchID.Valid {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var claimed []*entity.Transaction
+	for _, id := range ids {
+		if len(claimed) == limit {
+			break
+		}
+
+		t := r.transactions[id]
+		t.ClaimedBy = null.StringFrom(owner)
+		t.ClaimExpiresAt = null.TimeFrom(now.Add(lease))
+		copied := *t
+		claimed = append(claimed, &copied)
+	}
+
+	return claimed, nil
+}
+
+func (r *memoryRepository) UpdateClaimedTransaction(_ context.Context, _ *gorm.DB, item *entity.Transaction,
+	owner string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.transactions[item.ID]
+	if stored.ClaimedBy.String != owner || !stored.ClaimExpiresAt.Time.After(now) {
+		return transaction.ErrClaimLost
+	}
+
+	copied := *item
+	copied.ClaimedBy = null.String{}
+	copied.ClaimExpiresAt = null.Time{}
+	r.transactions[item.ID] = &copied
+
+	return nil
+}
+
+func (r *memoryRepository) UpdateTransaction(_ context.Context, tx *gorm.DB, item *entity.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *item
+	r.updates[tx] = append(r.updates[tx], &copied)
+
+	return nil
+}
+
+func (r *memoryRepository) ClaimDueBatches(_ context.Context, owner string, now time.Time, lease time.Duration,
+	limit int) ([]*entity.Batch, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []uint
+	for id, b := range r.batches {
+		if b.Status == entity.TransactionPending && !b.ScheduledAt.After(now) &&
+			(!b.ClaimExpiresAt.Valid || !b.ClaimExpiresAt.Time.After(now)) &&
+			(!b.NextAttemptAt.Valid || !b.NextAttemptAt.Time.After(now)) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var claimed []*entity.Batch
+	for _, id := range ids {
+		if len(claimed) == limit {
+			break
+		}
+
+		b := r.batches[id]
+		b.ClaimedBy = null.StringFrom(owner)
+		b.ClaimExpiresAt = null.TimeFrom(now.Add(lease))
+		copied := *b
+		copied.Legs = nil
+
+		var legIDs []uint
+		for legID, t := range r.transactions {
+			if t.BatchID.Valid && uint(t.BatchID.Int64) == id {
+				legIDs = append(legIDs, legID)
+			}
+		}
+		sort.Slice(legIDs, func(i, j int) bool { return legIDs[i] < legIDs[j] })
+		for _, legID := range legIDs {
+			leg := *r.transactions[legID]
+			copied.Legs = append(copied.Legs, &leg)
+		}
+
+		claimed = append(claimed, &copied)
+	}
+
+	return claimed, nil
+}
+
+func (r *memoryRepository) UpdateClaimedBatch(_ context.Context, _ *gorm.DB, batch *entity.Batch, owner string,
+	now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.batches[batch.ID]
+	if stored.ClaimedBy.String != owner || !stored.ClaimExpiresAt.Time.After(now) {
+		return transaction.ErrClaimLost
+	}
+
+	copied := *batch
+	copied.ClaimedBy = null.String{}
+	copied.ClaimExpiresAt = null.Time{}
+	r.batches[batch.ID] = &copied
+
+	return nil
+}
+
+func (r *memoryRepository) InTransaction(_ context.Context, fn func(tx *gorm.DB) error) error {
+	tx := &gorm.DB{}
+
+	err := fn(tx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Only committed balance operations, transaction updates and events take effect
+	if err == nil {
+		for _, operation := range r.staged[tx] {
+			r.executed[operation]++
+		}
+		for _, item := range r.updates[tx] {
+			r.transactions[item.ID] = item
+		}
+		r.events = append(r.events, r.stagedEvents[tx]...)
+	}
+	delete(r.staged, tx)
+	delete(r.updates, tx)
+	delete(r.stagedEvents, tx)
+
+	return err
+}
+
+func (r *memoryRepository) CreateEvents(_ context.Context, tx *gorm.DB, events []*outboxentity.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stagedEvents[tx] = append(r.stagedEvents[tx], events...)
+	return nil
+}
+
+// eventTypes returns the types of the committed events about the transaction with the given ID
+func (r *memoryRepository) eventTypes(id uint) []outboxentity.EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var types []outboxentity.EventType
+	for _, event := range r.events {
+		if event.AggregateID == id {
+			types = append(types, event.Type)
+		}
+	}
+
+	return types
+}
+
+func (r *memoryRepository) stage(tx *gorm.DB, operation string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.staged[tx] = append(r.staged[tx], operation)
+}
+
+// memoryAssetService stages both sides of transfers in the memoryRepository transaction they are executed in
+type memoryAssetService struct {
+	asset.Service
+
+	repository   *memoryRepository
+	onTransfer   func(req *request.TransferRequest)
+	transferErr  error
+	transferErrs map[string]error
+}
+
+func (s *memoryAssetService) Transfer(_ context.Context, tx *gorm.DB, req *request.TransferRequest) error {
+	if s.onTransfer != nil {
+		s.onTransfer(req)
+	}
+	if s.transferErr != nil {
+		return s.transferErr
+	}
+	if err := s.transferErrs[req.Reference.ID]; err != nil {
+		return err
+	}
+	s.repository.stage(tx, "withdraw:"+req.Reference.ID)
+	runtime.Gosched()
+	s.repository.stage(tx, "deposit:"+req.Reference.ID)
+
+	return nil
+}
+
+func (s *memoryAssetService) ReleaseHold(_ context.Context, tx *gorm.DB,
+	req *request.HoldRequest) (*assetentity.Asset, error) {
+	s.repository.stage(tx, fmt.Sprintf("release:%d:%s", req.WalletID, req.Amount))
+
+	return &assetentity.Asset{}, nil
+}
+
+// noRecurringService is a recurring.Service without any due schedules
+type noRecurringService struct {
+	recurring.Service
+}
+
+func (noRecurringService) MaterializeDueOccurrences(_ context.Context, _ time.Time, _ int) (int, error) {
+	return 0, nil
+}
+
+func pendingTransaction(id uint) *entity.Transaction {
+	return &entity.Transaction{
+		ID:                  id,
+		SourceWalletID:      1,
+		DestinationWalletID: 2,
+		AssetName:           "BTC",
+		Amount:              decimal.RequireFromString("0.5"),
+		Status:              entity.TransactionPending,
+		ScheduledAt:         time.Now().Add(-time.Minute),
+	}
+}
+
+func TestScheduler_ConcurrentInstances(t *testing.T) {
+	const (
+		instances    = 5
+		transactions = 200
+	)
+
+	var items []*entity.Transaction
+	for id := uint(1); id <= transactions; id++ {
+		items = append(items, pendingTransaction(id))
+	}
+
+	repository := newMemoryRepository(items...)
+	assetService := &memoryAssetService{repository: repository}
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 7}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		completed int
+	)
+
+	// Every instance keeps claiming batches until there is no due work left
+	for i := 0; i < instances; i++ {
+		s := NewScheduler(cfg, assetService, noRecurringService{}, repository, repository)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				count, err := s.RunOnce(context.Background())
+				assert.NoError(t, err)
+				if count == 0 {
+					return
+				}
+
+				mu.Lock()
+				completed += count
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, transactions, completed)
+	for _, item := range items {
+		id := item.ID
+		assert.Equal(t, entity.TransactionCompleted, repository.transactions[id].Status)
+		assert.False(t, repository.transactions[id].ClaimedBy.Valid)
+	}
+
+	// Each transaction must have moved funds exactly once
+	assert.Len(t, repository.executed, 2*transactions)
+	for operation, count := range repository.executed {
+		assert.Equal(t, 1, count, "operation %s executed %d times", operation, count)
+	}
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10}
+
+	t.Run("when lease of a crashed instance has expired then should reclaim the transaction", func(t *testing.T) {
+		item := pendingTransaction(1)
+		item.ClaimedBy = null.StringFrom("crashed-instance")
+		item.ClaimExpiresAt = null.TimeFrom(time.Now().Add(-time.Second))
+
+		repository := newMemoryRepository(item)
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository}, noRecurringService{}, repository,
+			repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, count)
+		assert.Equal(t, entity.TransactionCompleted, repository.transactions[1].Status)
+		assert.Equal(t, 1, repository.executed["withdraw:1"])
+		assert.Equal(t, []outboxentity.EventType{outboxentity.TransactionCompleted}, repository.eventTypes(1))
+	})
+
+	t.Run("when another instance holds a valid lease then should skip the transaction", func(t *testing.T) {
+		item := pendingTransaction(1)
+		item.ClaimedBy = null.StringFrom("other-instance")
+		item.ClaimExpiresAt = null.TimeFrom(time.Now().Add(time.Minute))
+
+		repository := newMemoryRepository(item)
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository}, noRecurringService{}, repository,
+			repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, count)
+		assert.Equal(t, entity.TransactionPending, repository.transactions[1].Status)
+		assert.Empty(t, repository.executed)
+	})
+
+	t.Run("when lease is lost during execution then should roll back the transaction", func(t *testing.T) {
+		repository := newMemoryRepository(pendingTransaction(1))
+		assetService := &memoryAssetService{repository: repository}
+
+		// Simulate the lease expiring and another instance claiming the row while funds are being moved
+		assetService.onTransfer = func(_ *request.TransferRequest) {
+			repository.mu.Lock()
+			defer repository.mu.Unlock()
+			repository.transactions[1].ClaimedBy = null.StringFrom("other-instance")
+		}
+
+		s := NewScheduler(cfg, assetService, noRecurringService{}, repository, repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, count)
+		assert.Equal(t, entity.TransactionPending, repository.transactions[1].Status)
+		assert.Equal(t, "other-instance", repository.transactions[1].ClaimedBy.String)
+		assert.Empty(t, repository.executed)
+		assert.Empty(t, repository.events)
+	})
+}
+
+func TestScheduler_RecordsFailures(t *testing.T) {
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10}
+
+	tests := []struct {
+		name           string
+		transferErr    error
+		expectedReason entity.FailureReason
+	}{
+		{
+			name:           "when source balance is insufficient then should fail with insufficient balance",
+			transferErr:    asset.ErrInsufficientBalance,
+			expectedReason: entity.FailureInsufficientBalance,
+		},
+		{
+			name:           "when held funds do not cover the amount then should fail with insufficient hold",
+			transferErr:    asset.ErrInsufficientHold,
+			expectedReason: entity.FailureInsufficientHold,
+		},
+		{
+			name:           "when wallet does not exist then should fail with wallet not found",
+			transferErr:    errors.Wrap(wallet.ErrWalletNotFound, "source wallet"),
+			expectedReason: entity.FailureWalletNotFound,
+		},
+		{
+			name:           "when wallet was deleted then should fail with wallet deleted",
+			transferErr:    wallet.ErrWalletDeleted,
+			expectedReason: entity.FailureWalletDeleted,
+		},
+		{
+			name:           "when asset was disabled then should fail with asset unavailable",
+			transferErr:    catalog.ErrAssetDisabled,
+			expectedReason: entity.FailureAssetUnavailable,
+		},
+		{
+			name:           "when an unexpected error occurs then should fail with internal error",
+			transferErr:    errors.New("connection reset"),
+			expectedReason: entity.FailureInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := newMemoryRepository(pendingTransaction(1))
+			s := NewScheduler(cfg, &memoryAssetService{repository: repository, transferErr: tt.transferErr},
+				noRecurringService{}, repository, repository)
+
+			count, err := s.RunOnce(context.Background())
+			require.NoError(t, err)
+
+			stored := repository.transactions[1]
+			assert.Equal(t, 0, count)
+			assert.Equal(t, entity.TransactionFailed, stored.Status)
+			assert.Equal(t, string(tt.expectedReason), stored.FailureReason.String)
+			assert.Equal(t, 1, stored.Attempts)
+			assert.True(t, stored.LastAttemptAt.Valid)
+			assert.False(t, stored.ClaimedBy.Valid)
+			assert.Empty(t, repository.executed)
+			assert.Equal(t, []outboxentity.EventType{outboxentity.TransactionFailed}, repository.eventTypes(1))
+
+			// A failed transaction must not be picked up again
+			count, err = s.RunOnce(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 0, count)
+			assert.Equal(t, 1, repository.transactions[1].Attempts)
+		})
+	}
+}
+
+func TestScheduler_RetriesTransientFailures(t *testing.T) {
+	now := time.Now()
+	common.Now = func() time.Time { return now }
+	defer func() { common.Now = time.Now }()
+
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10, MaxAttempts: 3,
+		RetryBaseDelay: 10, RetryMaxDelay: 3600}
+
+	repository := newMemoryRepository(pendingTransaction(1))
+	assetService := &memoryAssetService{repository: repository, transferErr: errors.New("wallet service timeout")}
+	s := NewScheduler(cfg, assetService, noRecurringService{}, repository, repository)
+
+	// The first attempt fails transiently and is scheduled for a retry after the base delay
+	count, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	stored := repository.transactions[1]
+	assert.Equal(t, entity.TransactionPending, stored.Status)
+	assert.Equal(t, string(entity.FailureInternalError), stored.FailureReason.String)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, now.Add(10*time.Second), stored.NextAttemptAt.Time)
+
+	// The transaction is not attempted again before its next attempt time
+	count, err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, repository.transactions[1].Attempts)
+
+	// The second attempt fails as well and the delay doubles
+	now = now.Add(10 * time.Second)
+	_, err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	stored = repository.transactions[1]
+	assert.Equal(t, entity.TransactionPending, stored.Status)
+	assert.Equal(t, 2, stored.Attempts)
+	assert.Equal(t, now.Add(20*time.Second), stored.NextAttemptAt.Time)
+
+	// Once the outage is over the transaction completes and its failure details are cleared
+	now = now.Add(20 * time.Second)
+	assetService.transferErr = nil
+	count, err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	stored = repository.transactions[1]
+	assert.Equal(t, entity.TransactionCompleted, stored.Status)
+	assert.Equal(t, 3, stored.Attempts)
+	assert.False(t, stored.FailureReason.Valid)
+	assert.False(t, stored.NextAttemptAt.Valid)
+	assert.Equal(t, 1, repository.executed["withdraw:1"])
+}
+
+func TestScheduler_RetriesConcurrentUpdates(t *testing.T) {
+	now := time.Now()
+	common.Now = func() time.Time { return now }
+	defer func() { common.Now = time.Now }()
+
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10, MaxAttempts: 3,
+		RetryBaseDelay: 10, RetryMaxDelay: 3600}
+
+	repository := newMemoryRepository(pendingTransaction(1))
+	s := NewScheduler(cfg, &memoryAssetService{repository: repository, transferErr: asset.ErrConcurrentUpdate},
+		noRecurringService{}, repository, repository)
+
+	count, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// A balance that kept changing concurrently is retried later instead of failing the transaction
+	stored := repository.transactions[1]
+	assert.Equal(t, entity.TransactionPending, stored.Status)
+	assert.Equal(t, string(entity.FailureConcurrentUpdate), stored.FailureReason.String)
+	assert.Equal(t, now.Add(10*time.Second), stored.NextAttemptAt.Time)
+}
+
+func TestScheduler_FailsWhenRetriesAreExhausted(t *testing.T) {
+	now := time.Now()
+	common.Now = func() time.Time { return now }
+	defer func() { common.Now = time.Now }()
+
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10, MaxAttempts: 2,
+		RetryBaseDelay: 10, RetryMaxDelay: 3600}
+
+	repository := newMemoryRepository(pendingTransaction(1))
+	s := NewScheduler(cfg, &memoryAssetService{repository: repository,
+		transferErr: errors.New("wallet service timeout")}, noRecurringService{}, repository, repository)
+
+	for attempt := 0; attempt < cfg.MaxAttempts; attempt++ {
+		_, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+		now = now.Add(time.Hour)
+	}
+
+	stored := repository.transactions[1]
+	assert.Equal(t, entity.TransactionFailed, stored.Status)
+	assert.Equal(t, string(entity.FailureInternalError), stored.FailureReason.String)
+	assert.Equal(t, 2, stored.Attempts)
+	assert.False(t, stored.NextAttemptAt.Valid)
+}
+
+func TestScheduler_HeldFunds(t *testing.T) {
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10}
+
+	t.Run("when a held transaction executes then should withdraw from the hold", func(t *testing.T) {
+		held := pendingTransaction(1)
+		held.Held = true
+
+		var fromHold bool
+		repository := newMemoryRepository(held)
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository,
+			onTransfer: func(req *request.TransferRequest) { fromHold = req.FromHold }},
+			noRecurringService{}, repository, repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, count)
+		assert.True(t, fromHold)
+		assert.False(t, repository.transactions[1].Held)
+	})
+
+	t.Run("when a held transaction fails terminally then should release the hold", func(t *testing.T) {
+		held := pendingTransaction(1)
+		held.Held = true
+
+		repository := newMemoryRepository(held)
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository,
+			transferErr: asset.ErrInsufficientHold}, noRecurringService{}, repository, repository)
+
+		_, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		stored := repository.transactions[1]
+		assert.Equal(t, entity.TransactionFailed, stored.Status)
+		assert.False(t, stored.Held)
+		assert.Equal(t, 1, repository.executed["release:1:0.5"])
+	})
+
+	t.Run("when a held transaction is retried then should keep the hold", func(t *testing.T) {
+		held := pendingTransaction(1)
+		held.Held = true
+
+		retrying := cfg
+		retrying.MaxAttempts = 3
+		retrying.RetryBaseDelay = 10
+		retrying.RetryMaxDelay = 60
+
+		repository := newMemoryRepository(held)
+		s := NewScheduler(retrying, &memoryAssetService{repository: repository,
+			transferErr: errors.New("wallet service timeout")}, noRecurringService{}, repository, repository)
+
+		_, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		stored := repository.transactions[1]
+		assert.Equal(t, entity.TransactionPending, stored.Status)
+		assert.True(t, stored.Held)
+		assert.Empty(t, repository.executed)
+	})
+}
+
+func TestScheduler_BatchTransfers(t *testing.T) {
+	cfg := config.SchedulerConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10}
+
+	newBatch := func() *memoryRepository {
+		var legs []*entity.Transaction
+		for id := uint(11); id <= 13; id++ {
+			leg := pendingTransaction(id)
+			leg.BatchID = null.IntFrom(1)
+			leg.Held = true
+			legs = append(legs, leg)
+		}
+
+		repository := newMemoryRepository(legs...)
+		repository.batches[1] = &entity.Batch{ID: 1, Status: entity.TransactionPending,
+			ScheduledAt: time.Now().Add(-time.Minute)}
+
+		return repository
+	}
+
+	t.Run("when a batch is due then should execute all legs together", func(t *testing.T) {
+		repository := newBatch()
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository}, noRecurringService{}, repository,
+			repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, count)
+		assert.Equal(t, entity.TransactionCompleted, repository.batches[1].Status)
+		assert.Equal(t, 1, repository.batches[1].Attempts)
+		for id := uint(11); id <= 13; id++ {
+			leg := repository.transactions[id]
+			assert.Equal(t, entity.TransactionCompleted, leg.Status)
+			assert.False(t, leg.Held)
+			assert.Equal(t, 1, repository.executed[fmt.Sprintf("withdraw:%d", id)])
+			assert.Equal(t, 1, repository.executed[fmt.Sprintf("deposit:%d", id)])
+			assert.Equal(t, []outboxentity.EventType{outboxentity.TransactionCompleted}, repository.eventTypes(id))
+		}
+
+		// A completed batch must not be picked up again
+		count, err = s.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("when a leg fails then should fail the whole batch and release all holds", func(t *testing.T) {
+		repository := newBatch()
+		s := NewScheduler(cfg, &memoryAssetService{repository: repository,
+			transferErrs: map[string]error{"12": asset.ErrInsufficientHold}}, noRecurringService{}, repository,
+			repository)
+
+		count, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, count)
+		assert.Equal(t, entity.TransactionFailed, repository.batches[1].Status)
+		assert.Equal(t, string(entity.FailureInsufficientHold), repository.batches[1].FailureReason.String)
+		assert.NotContains(t, repository.executed, "withdraw:11")
+		assert.Equal(t, 3, repository.executed["release:1:0.5"])
+		for id := uint(11); id <= 13; id++ {
+			leg := repository.transactions[id]
+			assert.Equal(t, entity.TransactionFailed, leg.Status)
+			assert.Equal(t, string(entity.FailureInsufficientHold), leg.FailureReason.String)
+			assert.False(t, leg.Held)
+			assert.Equal(t, []outboxentity.EventType{outboxentity.TransactionFailed}, repository.eventTypes(id))
+		}
+	})
+
+	t.Run("when a leg fails transiently then should retry the whole batch", func(t *testing.T) {
+		retrying := cfg
+		retrying.MaxAttempts = 3
+		retrying.RetryBaseDelay = 10
+		retrying.RetryMaxDelay = 60
+
+		repository := newBatch()
+		s := NewScheduler(retrying, &memoryAssetService{repository: repository,
+			transferErrs: map[string]error{"13": errors.New("wallet service timeout")}}, noRecurringService{},
+			repository, repository)
+
+		_, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		stored := repository.batches[1]
+		assert.Equal(t, entity.TransactionPending, stored.Status)
+		assert.True(t, stored.NextAttemptAt.Valid)
+		assert.Empty(t, repository.executed)
+		assert.Empty(t, repository.events)
+		for id := uint(11); id <= 13; id++ {
+			assert.Equal(t, entity.TransactionPending, repository.transactions[id].Status)
+			assert.True(t, repository.transactions[id].Held)
+		}
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, Backoff: worker.NewBackoff(10*time.Second, time.Minute, 0)}
+
+	t.Run("when only transient errors are retried then should fail business errors fast", func(t *testing.T) {
+		assert.True(t, policy.ShouldRetry(entity.FailureInternalError, 1))
+		assert.False(t, policy.ShouldRetry(entity.FailureInternalError, 5))
+		assert.True(t, policy.ShouldRetry(entity.FailureConcurrentUpdate, 1))
+		assert.False(t, policy.ShouldRetry(entity.FailureConcurrentUpdate, 5))
+		assert.False(t, policy.ShouldRetry(entity.FailureInsufficientBalance, 1))
+		assert.False(t, policy.ShouldRetry(entity.FailureInsufficientHold, 1))
+		assert.False(t, policy.ShouldRetry(entity.FailureWalletNotFound, 1))
+		assert.False(t, policy.ShouldRetry(entity.FailureAssetUnavailable, 1))
+	})
+
+	t.Run("when attempts grow then should back off exponentially up to the maximum delay", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, policy.NextDelay(1))
+		assert.Equal(t, 20*time.Second, policy.NextDelay(2))
+		assert.Equal(t, 40*time.Second, policy.NextDelay(3))
+		assert.Equal(t, time.Minute, policy.NextDelay(4))
+		assert.Equal(t, time.Minute, policy.NextDelay(30))
+	})
+}
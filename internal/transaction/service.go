@@ -2,34 +2,64 @@ package transaction
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/internal/asset"
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
+	assetrequest "github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	"github.com/safayildirim/asset-management-service/internal/outbox"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
 	transactionentity "github.com/safayildirim/asset-management-service/internal/transaction/entity"
 	"github.com/safayildirim/asset-management-service/internal/transaction/request"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
 	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"sort"
+	"strconv"
+	"time"
 )
 
 type Service interface {
 	ScheduleTransaction(ctx context.Context,
 		request *request.ScheduleTransactionRequest) (*transactionentity.Transaction, error)
+	Transfer(ctx context.Context, request *request.TransferRequest) (*transactionentity.Transaction, error)
+	TransferBatch(ctx context.Context, request *request.BatchTransferRequest) (*transactionentity.Batch, error)
+	ReverseTransaction(ctx context.Context, id uint,
+		request *request.ReverseTransactionRequest) (*transactionentity.Transaction, error)
+	AmendTransaction(ctx context.Context, id uint,
+		request *request.AmendTransactionRequest) (*transactionentity.Transaction, error)
 	GetTransactions(ctx context.Context,
-		request *request.GetTransactionsParams) ([]*transactionentity.Transaction, error)
+		request *request.GetTransactionsParams) (*common.Page[*transactionentity.Transaction], error)
+	GetTransaction(ctx context.Context, id uint) (*transactionentity.Transaction, error)
 	CancelTransaction(ctx context.Context, id uint) error
 }
 
 type service struct {
 	assetRepository       asset.Repository
+	assetService          asset.Service
 	transactionRepository Repository
+	outboxRepository      outbox.Repository
+	catalogService        catalog.Service
 	walletClient          wallet.Client
+	walletRules           *wallet.Rules
 }
 
-func NewService(assetRepository asset.Repository, transactionRepository Repository,
-	walletClient wallet.Client) Service {
-	return &service{assetRepository: assetRepository, transactionRepository: transactionRepository,
-		walletClient: walletClient}
+func NewService(assetRepository asset.Repository, assetService asset.Service, transactionRepository Repository,
+	outboxRepository outbox.Repository, catalogService catalog.Service, walletClient wallet.Client,
+	walletRules *wallet.Rules) Service {
+	return &service{assetRepository: assetRepository, assetService: assetService,
+		transactionRepository: transactionRepository, outboxRepository: outboxRepository,
+		catalogService: catalogService, walletClient: walletClient, walletRules: walletRules}
 }
 
-// ScheduleTransaction schedules a transaction between two wallets for a specific asset.
+// ScheduleTransaction schedules a transaction between two wallets for a specific asset and places a hold on the
+// amount in the source wallet, so that the funds cannot be withdrawn or promised to another transfer before the
+// transaction is executed. A TransactionScheduled event is written to the outbox along with the transaction.
 //
 // Parameters:
 // - ctx: The context for managing request lifecycle and cancellation.
@@ -45,26 +75,30 @@ func NewService(assetRepository asset.Repository, transactionRepository Reposito
 //   - An error if any validation or persistence step fails.
 //
 // Errors:
+//   - catalog.ErrUnknownAsset, catalog.ErrAssetDisabled: If the asset is not an enabled catalogue asset.
+//   - catalog.ErrAmountPrecision, catalog.ErrAmountBelowMinimum: If the amount does not fit the asset's precision.
+//   - wallet.ErrWalletDeleted, wallet.ErrNetworkMismatch, wallet.ErrAssetNotSupported: If the wallets cannot
+//     exchange the asset.
 //   - ErrAssetNotFound: If the asset is not found for either the source or destination wallet.
-//   - ErrInsufficientBalance: If the source wallet does not have enough balance for the transaction.
+//   - ErrInsufficientBalance: If the available balance of the source wallet is lower than the amount.
 //   - Any other error encountered during wallet or asset retrieval, or transaction persistence.
 func (s *service) ScheduleTransaction(ctx context.Context,
 	request *request.ScheduleTransactionRequest) (*transactionentity.Transaction, error) {
-	// Validate that the source wallet exists by fetching it from the wallet client
-	_, err := s.walletClient.GetWallet(ctx, request.SourceWalletID)
+	// Resolve the canonical asset symbol and validate the amount against its precision
+	definition, err := s.catalogService.ValidateAmount(ctx, request.AssetName, request.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate that the destination wallet exists by fetching it from the wallet client
-	_, err = s.walletClient.GetWallet(ctx, request.DestinationWalletID)
+	// Validate that both wallets exist and may exchange the asset
+	_, err = s.checkTransferWallets(ctx, request.SourceWalletID, request.DestinationWalletID, definition.Symbol)
 	if err != nil {
 		return nil, err
 	}
 
 	// Fetch the assets for both source and destination wallets with the specified asset name
-	assets, err := s.assetRepository.GetAsset(ctx, entity.Filters{
-		Name:     []string{request.AssetName},
+	assets, err := s.assetRepository.GetAsset(ctx, nil, entity.Filters{
+		Name:     []string{definition.Symbol},
 		WalletID: []uint{request.SourceWalletID, request.DestinationWalletID},
 	})
 	if err != nil {
@@ -84,8 +118,8 @@ func (s *service) ScheduleTransaction(ctx context.Context,
 		}
 	}
 
-	// Check if the source wallet has sufficient balance for the transaction
-	if sourceAsset.Amount < request.Amount {
+	// Check if the source wallet has sufficient available balance for the transaction
+	if sourceAsset.Available().LessThan(request.Amount) {
 		return nil, ErrInsufficientBalance
 	}
 
@@ -94,13 +128,601 @@ func (s *service) ScheduleTransaction(ctx context.Context,
 		SourceWalletID:      request.SourceWalletID,
 		DestinationWalletID: request.DestinationWalletID,
 		Amount:              request.Amount,
-		AssetName:           request.AssetName,
+		AssetName:           definition.Symbol,
 		Status:              transactionentity.TransactionPending,
 		ScheduledAt:         request.ScheduledAt,
+		Held:                true,
 	}
 
-	// Create a transaction object with the provided details and set its status to pending
-	return s.transactionRepository.CreateTransaction(ctx, nil, transaction)
+	// Reserve the funds and persist the transaction and its event atomically
+	err = s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		_, err := s.assetService.Hold(ctx, tx, &assetrequest.HoldRequest{
+			WalletID: request.SourceWalletID,
+			Name:     definition.Symbol,
+			Amount:   request.Amount,
+		})
+		if err != nil {
+			// The balance may have changed since it was checked above
+			if errors.Is(err, asset.ErrInsufficientBalance) {
+				return ErrInsufficientBalance
+			}
+
+			return err
+		}
+
+		transaction, err = s.transactionRepository.CreateTransaction(ctx, tx, transaction)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, outboxentity.TransactionScheduled, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// Transfer immediately moves an asset from one wallet to another. The withdrawal from the source wallet, the deposit
+// into the destination wallet and the completed transaction recording the transfer are persisted atomically.
+//
+// Parameters:
+// - ctx: The context for managing request lifecycle and cancellation.
+// - request: A request object containing details for the transfer, including:
+//   - SourceWalletID: The ID of the wallet sending the asset.
+//   - DestinationWalletID: The ID of the wallet receiving the asset.
+//   - AssetName: The name of the asset to be transferred.
+//   - Amount: The amount of the asset to transfer.
+//
+// Returns:
+//   - A pointer to the completed transaction entity.
+//   - An error if any validation or persistence step fails, in which case no balance is changed.
+//
+// Errors:
+//   - catalog.ErrUnknownAsset, catalog.ErrAssetDisabled: If the asset is not an enabled catalogue asset.
+//   - catalog.ErrAmountPrecision, catalog.ErrAmountBelowMinimum: If the amount does not fit the asset's precision.
+//   - wallet.ErrWalletNotFound: If either wallet does not exist.
+//   - wallet.ErrWalletDeleted, wallet.ErrNetworkMismatch, wallet.ErrAssetNotSupported: If the wallets cannot
+//     exchange the asset.
+//   - ErrInsufficientBalance: If the available balance of the source wallet is lower than the amount.
+//   - Any other error encountered during the balance changes or transaction persistence.
+func (s *service) Transfer(ctx context.Context,
+	request *request.TransferRequest) (*transactionentity.Transaction, error) {
+	// Resolve the canonical asset symbol and validate the amount against its precision
+	definition, err := s.catalogService.ValidateAmount(ctx, request.AssetName, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate that both wallets exist and may exchange the asset
+	wallets, err := s.checkTransferWallets(ctx, request.SourceWalletID, request.DestinationWalletID,
+		definition.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transfer is recorded as a transaction that completed at the time it was requested
+	transaction := &transactionentity.Transaction{
+		SourceWalletID:      request.SourceWalletID,
+		DestinationWalletID: request.DestinationWalletID,
+		Amount:              request.Amount,
+		AssetName:           definition.Symbol,
+		Status:              transactionentity.TransactionCompleted,
+		ScheduledAt:         common.Now(),
+	}
+
+	err = s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		return s.executeLeg(ctx, tx, transaction, ledgerentity.ReferenceTransfer, wallets)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// TransferBatch moves assets between wallets in a batch of transfers that either all succeed or all fail.
+//
+// All legs are validated before any balance changes: every asset and amount against the catalogue, every wallet via the
+// wallet client, and the amounts of all legs leaving the same source wallet together against its available balance.
+// A batch without a scheduled time, or with one that is not in the future, is executed immediately in a single database
+// transaction. Otherwise the amounts are held on the source wallets and the scheduler executes all legs together once
+// the batch is due.
+//
+// Parameters:
+// - ctx: The context for managing request lifecycle and cancellation.
+// - request: A request object containing the legs of the batch, each with:
+//   - SourceWalletID: The ID of the wallet sending the asset.
+//   - DestinationWalletID: The ID of the wallet receiving the asset.
+//   - AssetName: The name of the asset to be transferred.
+//   - Amount: The amount of the asset to transfer.
+//   - ScheduledAt: An optional time to execute the batch at.
+//
+// Returns:
+//   - A pointer to the batch with the resulting transaction of every leg, in the order of the request.
+//   - An error if any leg is invalid or any validation or persistence step fails, in which case no balance is changed.
+//
+// Errors:
+//   - catalog.ErrUnknownAsset, catalog.ErrAssetDisabled: If the asset of a leg is not an enabled catalogue asset.
+//   - catalog.ErrAmountPrecision, catalog.ErrAmountBelowMinimum: If the amount of a leg does not fit the asset.
+//   - wallet.ErrWalletNotFound: If any of the wallets does not exist.
+//   - wallet.ErrWalletDeleted, wallet.ErrNetworkMismatch, wallet.ErrAssetNotSupported: If the wallets of a leg cannot
+//     exchange its asset.
+//   - ErrInsufficientBalance: If the available balance of a source wallet is lower than the total of its legs.
+//   - Any other error encountered during the balance changes or transaction persistence.
+func (s *service) TransferBatch(ctx context.Context,
+	request *request.BatchTransferRequest) (*transactionentity.Batch, error) {
+	type balanceKey struct {
+		walletID uint
+		symbol   string
+	}
+
+	var (
+		legs     = make([]*transactionentity.Transaction, len(request.Legs))
+		wallets  = map[uint]bool{}
+		required = map[balanceKey]decimal.Decimal{}
+	)
+
+	// Resolve the canonical asset symbol of every leg and total the amounts per source wallet and asset
+	for i, leg := range request.Legs {
+		definition, err := s.catalogService.ValidateAmount(ctx, leg.AssetName, leg.Amount)
+		if err != nil {
+			return nil, legError(err, i, leg.SourceWalletID, leg.DestinationWalletID)
+		}
+
+		legs[i] = &transactionentity.Transaction{
+			SourceWalletID:      leg.SourceWalletID,
+			DestinationWalletID: leg.DestinationWalletID,
+			AssetName:           definition.Symbol,
+			Amount:              leg.Amount,
+		}
+
+		wallets[leg.SourceWalletID] = true
+		wallets[leg.DestinationWalletID] = true
+
+		key := balanceKey{walletID: leg.SourceWalletID, symbol: definition.Symbol}
+		required[key] = required[key].Add(leg.Amount)
+	}
+
+	// Validate that every wallet taking part in the batch exists, looking them all up at once
+	walletIDs := make([]uint, 0, len(wallets))
+	for id := range wallets {
+		walletIDs = append(walletIDs, id)
+	}
+	sort.Slice(walletIDs, func(i, j int) bool { return walletIDs[i] < walletIDs[j] })
+
+	walletsByID, err := wallet.GetAll(ctx, s.walletClient, walletIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate that the wallets of every leg may exchange its asset
+	for i, leg := range legs {
+		err := s.walletRules.CheckTransfer(walletsByID[leg.SourceWalletID], walletsByID[leg.DestinationWalletID],
+			leg.AssetName)
+		if err != nil {
+			return nil, legError(err, i, leg.SourceWalletID, leg.DestinationWalletID)
+		}
+	}
+
+	// Check the available balance of every source wallet against the total it sends
+	for key, amount := range required {
+		assets, err := s.assetRepository.GetAsset(ctx, nil, entity.Filters{
+			Name:     []string{key.symbol},
+			WalletID: []uint{key.walletID},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(assets) == 0 || assets[0].Available().LessThan(amount) {
+			return nil, apperror.AddDetails(errors.Wrapf(ErrInsufficientBalance, "wallet %d %s", key.walletID,
+				key.symbol), apperror.Details{"wallet_id": key.walletID, "asset_name": key.symbol})
+		}
+	}
+
+	now := common.Now()
+	batch := &transactionentity.Batch{Status: transactionentity.TransactionCompleted, ScheduledAt: now}
+	if request.ScheduledAt != nil && request.ScheduledAt.After(now) {
+		batch.Status = transactionentity.TransactionPending
+		batch.ScheduledAt = *request.ScheduledAt
+	}
+
+	err = s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		var err error
+
+		batch, err = s.transactionRepository.CreateBatch(ctx, tx, batch)
+		if err != nil {
+			return err
+		}
+
+		for i, leg := range legs {
+			leg.BatchID = null.IntFrom(int64(batch.ID))
+			leg.Status = batch.Status
+			leg.ScheduledAt = batch.ScheduledAt
+
+			if batch.Status == transactionentity.TransactionPending {
+				err = s.holdLeg(ctx, tx, leg)
+			} else {
+				// The wallets were validated for all legs at once, so they are not looked up again per leg
+				err = s.executeLeg(ctx, tx, leg, ledgerentity.ReferenceTransfer, walletsByID)
+			}
+			if err != nil {
+				return legError(err, i, leg.SourceWalletID, leg.DestinationWalletID)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	batch.Legs = legs
+
+	return batch, nil
+}
+
+// checkTransferWallets fetches both wallets of a transfer from the wallet client at once and checks that they may
+// exchange the asset with the given canonical symbol. The checked wallets are returned by ID.
+func (s *service) checkTransferWallets(ctx context.Context, sourceWalletID, destinationWalletID uint,
+	symbol string) (map[uint]*walletentity.Wallet, error) {
+	wallets, err := wallet.GetAll(ctx, s.walletClient, sourceWalletID, destinationWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.walletRules.CheckTransfer(wallets[sourceWalletID], wallets[destinationWalletID], symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallets, nil
+}
+
+// legError names the failing leg of a batch in err and, for catalogue errors, adds its index and wallets to the
+// details, so that clients can tell which leg failed
+func legError(err error, index int, sourceWalletID, destinationWalletID uint) error {
+	return apperror.AddDetails(errors.Wrapf(err, "leg %d", index), apperror.Details{
+		"leg":                   index,
+		"source_wallet_id":      sourceWalletID,
+		"destination_wallet_id": destinationWalletID,
+	})
+}
+
+// holdLeg reserves the amount of a scheduled batch leg on its source wallet and persists the leg along with its
+// TransactionScheduled event
+func (s *service) holdLeg(ctx context.Context, tx *gorm.DB, leg *transactionentity.Transaction) error {
+	_, err := s.assetService.Hold(ctx, tx, &assetrequest.HoldRequest{
+		WalletID: leg.SourceWalletID,
+		Name:     leg.AssetName,
+		Amount:   leg.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, asset.ErrInsufficientBalance) {
+			return ErrInsufficientBalance
+		}
+
+		return err
+	}
+
+	leg.Held = true
+	_, err = s.transactionRepository.CreateTransaction(ctx, tx, leg)
+	if err != nil {
+		return err
+	}
+
+	return s.recordEvent(ctx, tx, outboxentity.TransactionScheduled, leg)
+}
+
+// executeLeg persists an immediate transfer, moves its amount between the wallets and writes its TransactionCompleted
+// event. The transaction is persisted first so that the journal of the transfer can reference it with the given
+// reference type. Wallets already validated by the caller are passed on by ID, the others are looked up.
+func (s *service) executeLeg(ctx context.Context, tx *gorm.DB, leg *transactionentity.Transaction,
+	referenceType ledgerentity.ReferenceType, wallets map[uint]*walletentity.Wallet) error {
+	_, err := s.transactionRepository.CreateTransaction(ctx, tx, leg)
+	if err != nil {
+		return err
+	}
+
+	reference := ledgerentity.Reference{
+		Type: referenceType,
+		ID:   strconv.FormatUint(uint64(leg.ID), 10),
+	}
+
+	err = s.assetService.Transfer(ctx, tx, &assetrequest.TransferRequest{
+		SourceWalletID:      leg.SourceWalletID,
+		DestinationWalletID: leg.DestinationWalletID,
+		Name:                leg.AssetName,
+		Amount:              leg.Amount,
+		Reference:           reference,
+		SourceWallet:        wallets[leg.SourceWalletID],
+		DestinationWallet:   wallets[leg.DestinationWalletID],
+	})
+	if err != nil {
+		if errors.Is(err, asset.ErrInsufficientBalance) {
+			return ErrInsufficientBalance
+		}
+
+		return err
+	}
+
+	return s.recordEvent(ctx, tx, outboxentity.TransactionCompleted, leg)
+}
+
+// recordEvent writes an event about a transaction to the outbox as part of tx
+func (s *service) recordEvent(ctx context.Context, tx *gorm.DB, eventType outboxentity.EventType,
+	transaction *transactionentity.Transaction) error {
+	return outbox.Record(ctx, s.outboxRepository, tx, eventType, transaction.ID, transaction)
+}
+
+// ReverseTransaction undoes a completed transaction, fully or in part, with a compensating transfer from its
+// destination wallet back to its source wallet.
+//
+// The compensating transfer is recorded as a completed transaction whose ReversalOf references the original one. A
+// transaction can be reversed in several steps until its whole amount has been given back, at which point its status
+// becomes "reversed". If the destination wallet no longer has the amount available, the reversal fails unless a partial
+// reversal is allowed, in which case the available balance is given back.
+//
+// Parameters:
+// - ctx: The context for managing request lifecycle and cancellation.
+// - id: The ID of the transaction to reverse.
+// - request: A request object containing:
+//   - Amount: The amount to give back, defaulting to the amount that has not been reversed yet.
+//   - AllowPartial: Whether to give back the available balance of the destination wallet if it is lower.
+//
+// Returns:
+//   - A pointer to the compensating transaction.
+//   - An error if the transaction cannot be reversed or any persistence step fails, in which case no balance changes.
+//
+// Errors:
+//   - ErrTransactionNotFound: If the transaction with the given ID does not exist.
+//   - ErrTransactionCannotBeReversed: If the transaction is not completed, is already fully reversed or is a reversal.
+//   - ErrReversalExceedsAmount: If the amount is larger than the amount that has not been reversed yet.
+//   - ErrInsufficientBalance: If the destination wallet cannot give back the amount and no partial reversal is allowed.
+func (s *service) ReverseTransaction(ctx context.Context, id uint,
+	request *request.ReverseTransactionRequest) (*transactionentity.Transaction, error) {
+	var reversal *transactionentity.Transaction
+
+	err := s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		// Fetch and lock the transaction so that concurrent reversals cannot give back more than its amount
+		original, err := s.transactionRepository.LockTransaction(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// Only completed transfers can be reversed, and reversals are not reversed themselves
+		if original.Status != transactionentity.TransactionCompleted || original.ReversalOf.Valid {
+			return ErrTransactionCannotBeReversed
+		}
+
+		remaining := original.Amount.Sub(original.ReversedAmount)
+		amount := remaining
+		if request.Amount != nil {
+			if request.Amount.GreaterThan(remaining) {
+				return ErrReversalExceedsAmount
+			}
+			amount = *request.Amount
+		}
+
+		// Check how much the destination wallet can give back
+		assets, err := s.assetRepository.GetAsset(ctx, tx, entity.Filters{
+			Name:     []string{original.AssetName},
+			WalletID: []uint{original.DestinationWalletID},
+		})
+		if err != nil {
+			return err
+		}
+
+		available := decimal.Zero
+		if len(assets) > 0 {
+			available = assets[0].Available()
+		}
+
+		if available.LessThan(amount) {
+			if !request.AllowPartial || !available.IsPositive() {
+				return ErrInsufficientBalance
+			}
+			amount = available
+		}
+
+		// Move the amount back with a compensating transfer linked to the original transaction
+		reversal = &transactionentity.Transaction{
+			SourceWalletID:      original.DestinationWalletID,
+			DestinationWalletID: original.SourceWalletID,
+			AssetName:           original.AssetName,
+			Amount:              amount,
+			Status:              transactionentity.TransactionCompleted,
+			ScheduledAt:         common.Now(),
+			ReversalOf:          null.IntFrom(int64(original.ID)),
+		}
+
+		err = s.executeLeg(ctx, tx, reversal, ledgerentity.ReferenceReversal, nil)
+		if err != nil {
+			return err
+		}
+
+		// Keep track of the amount given back and mark the transaction reversed once nothing is left
+		original.ReversedAmount = original.ReversedAmount.Add(amount)
+		if original.ReversedAmount.Equal(original.Amount) {
+			original.Status = transactionentity.TransactionReversed
+		}
+		original.UpdatedAt = null.TimeFrom(common.Now())
+
+		return s.transactionRepository.UpdateTransaction(ctx, tx, original)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reversal, nil
+}
+
+// AmendTransaction changes the destination wallet, amount or scheduled time of a pending transaction while keeping its
+// ID.
+//
+// The amended transaction goes through the same validations as ScheduleTransaction: both wallets must exist and hold
+// the asset, the amount must fit the asset's precision and the source wallet must have it available. The wallets are
+// checked with the wallet service before the transaction is locked. The hold placed on the source wallet is adjusted to
+// the new amount. A transaction waiting for a retry is attempted again at its (new)
+// scheduled time.
+//
+// Parameters:
+// - ctx: The context for managing request lifecycle and cancellation.
+// - id: The ID of the transaction to amend.
+// - request: A request object with the fields to change, each optional:
+//   - DestinationWalletID: The ID of the wallet that should receive the asset.
+//   - Amount: The amount of the asset to transfer.
+//   - ScheduledAt: The time to execute the transaction at.
+//
+// Returns:
+//   - A pointer to the amended transaction.
+//   - An error if the transaction cannot be amended or any validation or persistence step fails.
+//
+// Errors:
+//   - ErrTransactionNotFound: If the transaction with the given ID does not exist.
+//   - ErrTransactionCannotBeAmended: If the transaction is not pending, is being executed or is a leg of a batch.
+//   - ErrConcurrentAmendment: If the transaction was amended by another request while this one was validated.
+//   - ErrSameWallet: If the new destination wallet is the source wallet.
+//   - wallet.ErrWalletDeleted, wallet.ErrNetworkMismatch, wallet.ErrAssetNotSupported: If the wallets cannot
+//     exchange the asset.
+//   - catalog.ErrAmountPrecision, catalog.ErrAmountBelowMinimum: If the amount does not fit the asset's precision.
+//   - ErrAssetNotFound: If the asset is not found for either the source or destination wallet.
+//   - ErrInsufficientBalance: If the available balance of the source wallet does not cover the new amount.
+func (s *service) AmendTransaction(ctx context.Context, id uint,
+	request *request.AmendTransactionRequest) (*transactionentity.Transaction, error) {
+	// Validate the amended transaction against the catalogue and the wallet service before locking it, so that the lock
+	// is not held while waiting for other services
+	current, err := s.GetTransaction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !amendable(current) {
+		return nil, ErrTransactionCannotBeAmended
+	}
+
+	destinationWalletID := current.DestinationWalletID
+	if request.DestinationWalletID != nil {
+		if *request.DestinationWalletID == current.SourceWalletID {
+			return nil, ErrSameWallet
+		}
+		destinationWalletID = *request.DestinationWalletID
+	}
+	amount := current.Amount
+	if request.Amount != nil {
+		amount = *request.Amount
+	}
+
+	// Validate the amount against the precision of the asset
+	_, err = s.catalogService.ValidateAmount(ctx, current.AssetName, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate that both wallets still exist and may exchange the asset
+	_, err = s.checkTransferWallets(ctx, current.SourceWalletID, destinationWalletID, current.AssetName)
+	if err != nil {
+		return nil, err
+	}
+
+	var transaction *transactionentity.Transaction
+
+	err = s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		var err error
+
+		// Fetch and lock the transaction so that the scheduler cannot claim it while it is being amended
+		transaction, err = s.transactionRepository.LockTransaction(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// The transaction may have been claimed by the scheduler or amended by another request since it was validated
+		if !amendable(transaction) {
+			return ErrTransactionCannotBeAmended
+		}
+		if transaction.DestinationWalletID != current.DestinationWalletID || !transaction.Amount.Equal(current.Amount) {
+			return ErrConcurrentAmendment
+		}
+
+		previousAmount := transaction.Amount
+		transaction.DestinationWalletID = destinationWalletID
+		transaction.Amount = amount
+		if request.ScheduledAt != nil {
+			transaction.ScheduledAt = *request.ScheduledAt
+		}
+
+		// Ensure that both wallets hold the asset
+		assets, err := s.assetRepository.GetAsset(ctx, tx, entity.Filters{
+			Name:     []string{transaction.AssetName},
+			WalletID: []uint{transaction.SourceWalletID, transaction.DestinationWalletID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(assets) < 2 {
+			return ErrAssetNotFound
+		}
+
+		// Adjust the funds held on the source wallet to the new amount, or check the balance of an unheld occurrence
+		err = s.adjustHold(ctx, tx, transaction, previousAmount, assets)
+		if err != nil {
+			return err
+		}
+
+		transaction.NextAttemptAt = null.Time{}
+		transaction.UpdatedAt = null.TimeFrom(common.Now())
+
+		return s.transactionRepository.UpdateTransaction(ctx, tx, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// amendable reports whether a transaction can be amended: only pending transactions that are not being executed can
+// be, and batch legs only change with their batch
+func amendable(transaction *transactionentity.Transaction) bool {
+	return transaction.Status == transactionentity.TransactionPending && !transaction.BatchID.Valid &&
+		!(transaction.ClaimExpiresAt.Valid && transaction.ClaimExpiresAt.Time.After(common.Now()))
+}
+
+// adjustHold changes the funds held for an amended transaction by the difference between its new and previous amount.
+// Transactions without a hold, such as occurrences of recurring schedules, only have their new amount checked against
+// the available balance of the source wallet.
+func (s *service) adjustHold(ctx context.Context, tx *gorm.DB, transaction *transactionentity.Transaction,
+	previousAmount decimal.Decimal, assets []*entity.Asset) error {
+	if !transaction.Held {
+		for _, a := range assets {
+			if a.WalletID == transaction.SourceWalletID && a.Available().LessThan(transaction.Amount) {
+				return ErrInsufficientBalance
+			}
+		}
+
+		return nil
+	}
+
+	difference := transaction.Amount.Sub(previousAmount)
+	holdRequest := &assetrequest.HoldRequest{
+		WalletID: transaction.SourceWalletID,
+		Name:     transaction.AssetName,
+		Amount:   difference.Abs(),
+	}
+
+	var err error
+	switch {
+	case difference.IsPositive():
+		_, err = s.assetService.Hold(ctx, tx, holdRequest)
+		if errors.Is(err, asset.ErrInsufficientBalance) {
+			return ErrInsufficientBalance
+		}
+	case difference.IsNegative():
+		_, err = s.assetService.ReleaseHold(ctx, tx, holdRequest)
+	}
+
+	return err
 }
 
 // GetTransactions retrieves a list of transactions based on the provided filters.
@@ -115,22 +737,79 @@ func (s *service) ScheduleTransaction(ctx context.Context,
 //   - SourceWalletID: A list of source wallet IDs to filter by.
 //   - DestinationWalletID: A list of destination wallet IDs to filter by.
 //   - Status: A list of transaction statuses to filter by.
+//   - RecurringScheduleID: A list of recurring schedule IDs whose occurrences to filter by.
+//   - BatchID: A list of batch transfer IDs whose legs to filter by.
+//   - AssetName: A list of asset names to filter by.
+//   - MinAmount, MaxAmount: Optional inclusive bounds of the amount.
+//   - ScheduledStart, ScheduledEnd, CreatedStart, CreatedEnd: Optional inclusive bounds of the schedule and creation
+//     times.
+//   - Limit, Sort, Cursor: The page size, the sort order and the cursor of the page to fetch.
 //
 // Returns:
-//   - A slice of transactions that match the filter criteria.
-//   - An error if the repository query fails.
+//   - The page of transactions that match the filter criteria, along with the cursor of the next page if there is one.
+//   - An error if the cursor is invalid or the repository query fails.
 func (s *service) GetTransactions(ctx context.Context,
-	request *request.GetTransactionsParams) ([]*transactionentity.Transaction, error) {
+	request *request.GetTransactionsParams) (*common.Page[*transactionentity.Transaction], error) {
+	page, err := common.NewPageQuery(request.Limit, request.Sort, request.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	assetNames := make([]string, 0, len(request.AssetName))
+	for _, name := range request.AssetName {
+		assetNames = append(assetNames, catalog.NormalizeSymbol(name))
+	}
+
 	filters := transactionentity.Filters{
 		ID:                  request.ID,
 		SourceWalletID:      request.SourceWalletID,
 		DestinationWalletID: request.DestinationWalletID,
 		Status:              request.Status,
+		RecurringScheduleID: request.RecurringScheduleID,
+		BatchID:             request.BatchID,
+		AssetName:           assetNames,
+		MinAmount:           request.MinAmount,
+		MaxAmount:           request.MaxAmount,
+		ScheduledStart:      request.ScheduledStart,
+		ScheduledEnd:        request.ScheduledEnd,
+		CreatedStart:        request.CreatedStart,
+		CreatedEnd:          request.CreatedEnd,
+		Page:                page,
 	}
-	return s.transactionRepository.GetTransactions(ctx, filters)
+	transactions, err := s.transactionRepository.GetTransactions(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.NewPage(transactions, page, func(item *transactionentity.Transaction) (string, uint) {
+		switch page.Sort.Field {
+		case "created_at":
+			return item.CreatedAt.Format(time.RFC3339Nano), item.ID
+		case "scheduled_at":
+			return item.ScheduledAt.Format(time.RFC3339Nano), item.ID
+		case "amount":
+			return item.Amount.String(), item.ID
+		}
+		return "", item.ID
+	}), nil
 }
 
-// CancelTransaction cancels a transaction with the given ID.
+// GetTransaction fetches a single transaction by its ID, returning ErrTransactionNotFound if it does not exist
+func (s *service) GetTransaction(ctx context.Context, id uint) (*transactionentity.Transaction, error) {
+	transactions, err := s.transactionRepository.GetTransactions(ctx, transactionentity.Filters{ID: []uint{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transactions) == 0 {
+		return nil, ErrTransactionNotFound
+	}
+
+	return transactions[0], nil
+}
+
+// CancelTransaction cancels a transaction with the given ID, releases the funds held for it and writes a
+// TransactionCancelled event to the outbox.
 //
 // Parameters:
 //   - ctx: The context for managing request lifecycle and cancellation.
@@ -141,27 +820,56 @@ func (s *service) GetTransactions(ctx context.Context,
 //
 // Errors:
 //   - ErrTransactionNotFound: If the transaction with the given ID does not exist.
-//   - ErrTransactionCannotBeDeleted: If the transaction is not in a "Pending" state.
+//   - ErrTransactionCannotBeDeleted: If the transaction is not in a "Pending" state, is being executed or is a leg of a
+//     batch transfer.
 func (s *service) CancelTransaction(ctx context.Context, id uint) error {
-	// Fetch the transaction by ID from the transaction repository
-	transaction, err := s.transactionRepository.GetTransactions(ctx, transactionentity.Filters{ID: []uint{id}})
-	if err != nil {
-		return err
-	}
+	return s.transactionRepository.InTransaction(ctx, func(tx *gorm.DB) error {
+		// Fetch and lock the transaction so that the scheduler cannot claim it while it is being cancelled
+		transaction, err := s.transactionRepository.LockTransaction(ctx, tx, id)
+		if err != nil {
+			return err
+		}
 
-	// Check if the transaction exists
-	if len(transaction) == 0 {
-		return ErrTransactionNotFound
-	}
+		// Ensure that the transaction is in a pending state before cancellation
+		if transaction.Status != transactionentity.TransactionPending {
+			return ErrTransactionCannotBeDeleted
+		}
 
-	// Ensure that the transaction is in a pending state before cancellation
-	if transaction[0].Status != transactionentity.TransactionPending {
-		return ErrTransactionCannotBeDeleted
-	}
+		// The legs of a batch transfer are executed all-or-nothing and cannot be cancelled on their own
+		if transaction.BatchID.Valid {
+			return ErrTransactionCannotBeDeleted
+		}
+
+		// A transaction claimed by a scheduler instance is being executed right now
+		if transaction.ClaimExpiresAt.Valid && transaction.ClaimExpiresAt.Time.After(common.Now()) {
+			return ErrTransactionCannotBeDeleted
+		}
+
+		// Return the held funds to the available balance of the source wallet
+		if transaction.Held {
+			_, err = s.assetService.ReleaseHold(ctx, tx, &assetrequest.HoldRequest{
+				WalletID: transaction.SourceWalletID,
+				Name:     transaction.AssetName,
+				Amount:   transaction.Amount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Update the transaction status to "Cancelled"
+		transaction.Status = transactionentity.TransactionCancelled
+		transaction.Held = false
+		transaction.ClaimedBy = null.String{}
+		transaction.ClaimExpiresAt = null.Time{}
+		transaction.UpdatedAt = null.TimeFrom(common.Now())
 
-	// Update the transaction status to "Cancelled"
-	transaction[0].Status = transactionentity.TransactionCancelled
+		// Persist the updated transaction to the database
+		err = s.transactionRepository.UpdateTransaction(ctx, tx, transaction)
+		if err != nil {
+			return err
+		}
 
-	// Persist the updated transaction to the database
-	return s.transactionRepository.UpdateTransaction(ctx, nil, transaction[0])
+		return s.recordEvent(ctx, tx, outboxentity.TransactionCancelled, transaction)
+	})
 }
@@ -3,36 +3,49 @@ package transaction
 import (
 	"context"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/asset"
 	"github.com/safayildirim/asset-management-service/internal/asset/entity"
 	assetmock "github.com/safayildirim/asset-management-service/internal/asset/mock"
+	assetrequest "github.com/safayildirim/asset-management-service/internal/asset/request"
+	"github.com/safayildirim/asset-management-service/internal/catalog"
+	catalogentity "github.com/safayildirim/asset-management-service/internal/catalog/entity"
+	catalogmock "github.com/safayildirim/asset-management-service/internal/catalog/mock"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	ledgerentity "github.com/safayildirim/asset-management-service/internal/ledger/entity"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	outboxmock "github.com/safayildirim/asset-management-service/internal/outbox/mock"
 	transactionentity "github.com/safayildirim/asset-management-service/internal/transaction/entity"
 	transactionmock "github.com/safayildirim/asset-management-service/internal/transaction/mock"
 	"github.com/safayildirim/asset-management-service/internal/transaction/request"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	walletpkg "github.com/safayildirim/asset-management-service/pkg/client/wallet"
 	walletentity "github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
 	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 func TestService_ScheduleTransaction(t *testing.T) {
 	tests := []struct {
-		name                     string
-		request                  *request.ScheduleTransactionRequest
-		mockSourceWallet         bool
-		mockSourceWalletError    error
-		mockSourceWalletResponse *walletentity.Wallet
-		mockDestWallet           bool
-		mockDestWalletError      error
-		mockDestWalletResponse   *walletentity.Wallet
-		mockAsset                bool
-		mockAssetsErr            error
-		mockAssetsResponse       []*entity.Asset
-		mockTransaction          bool
-		mockTransactionErr       error
-		mockTransactionResponse  *transactionentity.Transaction
-		expectedResult           *transactionentity.Transaction
-		expectedError            error
+		name                    string
+		request                 *request.ScheduleTransactionRequest
+		mockDefinitionErr       error
+		mockWallets             map[uint]*walletentity.Wallet
+		mockAsset               bool
+		mockAssetsErr           error
+		mockAssetsResponse      []*entity.Asset
+		mockHold                bool
+		mockHoldErr             error
+		mockTransaction         bool
+		mockTransactionErr      error
+		mockTransactionResponse *transactionentity.Transaction
+		expectedResult          *transactionentity.Transaction
+		expectedError           error
 	}{
 		{
 			name: "when everything is ok then should return transaction",
@@ -40,25 +53,23 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 			},
-			mockSourceWallet:         true,
-			mockSourceWalletResponse: &walletentity.Wallet{ID: 1},
-			mockDestWallet:           true,
-			mockDestWalletResponse:   &walletentity.Wallet{ID: 2},
-			mockAsset:                true,
+			mockWallets: map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:   true,
 			mockAssetsResponse: []*entity.Asset{
-				{ID: 1, WalletID: 1, Name: "BTC", Amount: 20.0},
-				{ID: 2, WalletID: 2, Name: "BTC", Amount: 0},
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(20)},
+				{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(0)},
 			},
 			mockAssetsErr:   nil,
+			mockHold:        true,
 			mockTransaction: true,
 			mockTransactionResponse: &transactionentity.Transaction{
 				ID:                  1,
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 				Status:              transactionentity.TransactionPending,
 			},
 			mockTransactionErr: nil,
@@ -67,22 +78,32 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 				Status:              transactionentity.TransactionPending,
 			},
 			expectedError: nil,
 		},
+		{
+			name: "when asset is unknown then should return error",
+			request: &request.ScheduleTransactionRequest{
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "Bitcoin",
+				Amount:              decimal.NewFromInt(10),
+			},
+			mockDefinitionErr: catalog.ErrUnknownAsset,
+			expectedError:     catalog.ErrUnknownAsset,
+		},
 		{
 			name: "when source wallet not found then should return error",
 			request: &request.ScheduleTransactionRequest{
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 			},
-			mockSourceWallet:      true,
-			mockSourceWalletError: errors.New("wallet not found"),
-			expectedError:         errors.New("wallet not found"),
+			mockWallets:   map[uint]*walletentity.Wallet{2: {ID: 2}},
+			expectedError: errors.New("wallet 1: wallet not found"),
 		},
 		{
 			name: "when destination wallet not found then should return error",
@@ -90,13 +111,32 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 			},
-			mockSourceWallet:         true,
-			mockSourceWalletResponse: &walletentity.Wallet{ID: 1},
-			mockDestWallet:           true,
-			mockDestWalletError:      errors.New("wallet not found"),
-			expectedError:            errors.New("wallet not found"),
+			mockWallets:   map[uint]*walletentity.Wallet{1: {ID: 1}},
+			expectedError: errors.New("wallet 2: wallet not found"),
+		},
+		{
+			name: "when destination wallet is deleted then should return error",
+			request: &request.ScheduleTransactionRequest{
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "BTC",
+				Amount:              decimal.NewFromInt(10),
+			},
+			mockWallets:   map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2, DeletedAt: null.TimeFrom(time.Now())}},
+			expectedError: walletpkg.ErrWalletDeleted,
+		},
+		{
+			name: "when wallets are on different networks then should return error",
+			request: &request.ScheduleTransactionRequest{
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "BTC",
+				Amount:              decimal.NewFromInt(10),
+			},
+			mockWallets:   map[uint]*walletentity.Wallet{1: {ID: 1, Network: "bitcoin"}, 2: {ID: 2, Network: "ethereum"}},
+			expectedError: walletpkg.ErrNetworkMismatch,
 		},
 		{
 			name: "when asset not found then should return error",
@@ -104,15 +144,12 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 			},
-			mockSourceWallet:         true,
-			mockSourceWalletResponse: &walletentity.Wallet{ID: 1},
-			mockDestWallet:           true,
-			mockDestWalletResponse:   &walletentity.Wallet{ID: 2},
-			mockAsset:                true,
-			mockAssetsErr:            errors.New("asset not found"),
-			expectedError:            errors.New("asset not found"),
+			mockWallets:   map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:     true,
+			mockAssetsErr: errors.New("asset not found"),
+			expectedError: errors.New("asset not found"),
 		},
 		{
 			name: "when destination asset not found then should return error",
@@ -120,15 +157,12 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              10.0,
+				Amount:              decimal.NewFromInt(10),
 			},
-			mockSourceWallet:         true,
-			mockSourceWalletResponse: &walletentity.Wallet{ID: 1},
-			mockDestWallet:           true,
-			mockDestWalletResponse:   &walletentity.Wallet{ID: 2},
-			mockAsset:                true,
+			mockWallets: map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:   true,
 			mockAssetsResponse: []*entity.Asset{
-				{ID: 1, WalletID: 1, Name: "BTC", Amount: 20.0},
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(20)},
 			},
 			expectedError: errors.New("asset not found"),
 		},
@@ -138,48 +172,107 @@ func TestService_ScheduleTransaction(t *testing.T) {
 				SourceWalletID:      1,
 				DestinationWalletID: 2,
 				AssetName:           "BTC",
-				Amount:              50.0,
+				Amount:              decimal.NewFromInt(50),
 			},
-			mockSourceWallet:         true,
-			mockSourceWalletResponse: &walletentity.Wallet{ID: 1},
-			mockDestWallet:           true,
-			mockDestWalletResponse:   &walletentity.Wallet{ID: 2},
-			mockAsset:                true,
+			mockWallets: map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:   true,
 			mockAssetsResponse: []*entity.Asset{
-				{ID: 1, WalletID: 1, Name: "BTC", Amount: 10.0},
-				{ID: 2, WalletID: 2, Name: "BTC", Amount: 0},
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(10)},
+				{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(0)},
 			},
 			mockTransactionErr: nil,
 			expectedResult:     nil,
 			expectedError:      ErrInsufficientBalance,
 		},
+		{
+			name: "when part of the balance is held then should only consider the available balance",
+			request: &request.ScheduleTransactionRequest{
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "BTC",
+				Amount:              decimal.NewFromInt(10),
+			},
+			mockWallets: map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:   true,
+			mockAssetsResponse: []*entity.Asset{
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(15), Held: decimal.NewFromInt(6)},
+				{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(0)},
+			},
+			expectedError: ErrInsufficientBalance,
+		},
+		{
+			name: "when hold cannot be placed then should return insufficient balance",
+			request: &request.ScheduleTransactionRequest{
+				SourceWalletID:      1,
+				DestinationWalletID: 2,
+				AssetName:           "BTC",
+				Amount:              decimal.NewFromInt(10),
+			},
+			mockWallets: map[uint]*walletentity.Wallet{1: {ID: 1}, 2: {ID: 2}},
+			mockAsset:   true,
+			mockAssetsResponse: []*entity.Asset{
+				{ID: 1, WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(20)},
+				{ID: 2, WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(0)},
+			},
+			mockHold:      true,
+			mockHoldErr:   asset.ErrInsufficientBalance,
+			expectedError: ErrInsufficientBalance,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAssetRepo := assetmock.NewMockAssetRepository(t)
 			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockAssetRepo, mockTransactionRepo, mockWalletClient)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 
-			if tt.mockSourceWallet {
-				mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.SourceWalletID).
-					Return(tt.mockSourceWalletResponse, tt.mockSourceWalletError).Once()
+			definition := &catalogentity.AssetDefinition{Symbol: tt.request.AssetName, Decimals: 8, Enabled: true}
+			if tt.mockDefinitionErr != nil {
+				definition = nil
 			}
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, tt.request.AssetName, tt.request.Amount).
+				Return(definition, tt.mockDefinitionErr).Once()
 
-			if tt.mockDestWallet {
-				mockWalletClient.EXPECT().GetWallet(mock.Anything, tt.request.DestinationWalletID).
-					Return(tt.mockDestWalletResponse, tt.mockDestWalletError).Once()
+			if tt.mockWallets != nil {
+				mockWalletClient.EXPECT().GetWallets(mock.Anything,
+					[]uint{tt.request.SourceWalletID, tt.request.DestinationWalletID}).Return(tt.mockWallets, nil).Once()
 			}
 
 			if tt.mockAsset {
-				mockAssetRepo.EXPECT().GetAsset(mock.Anything, mock.Anything).
+				mockAssetRepo.EXPECT().GetAsset(mock.Anything, mock.Anything, mock.Anything).
 					Return(tt.mockAssetsResponse, tt.mockAssetsErr).Once()
 			}
 
+			if tt.mockHold {
+				mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+					WalletID: tt.request.SourceWalletID,
+					Name:     tt.request.AssetName,
+					Amount:   tt.request.Amount,
+				}).Return(&entity.Asset{}, tt.mockHoldErr).Once()
+			}
+
 			if tt.mockTransaction {
-				mockTransactionRepo.EXPECT().CreateTransaction(mock.Anything, mock.Anything,
-					mock.Anything).Return(tt.mockTransactionResponse, tt.mockTransactionErr).Once()
+				mockTransactionRepo.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(item *transactionentity.Transaction) bool {
+						return item.Held
+					})).Return(tt.mockTransactionResponse, tt.mockTransactionErr).Once()
+			}
+
+			if tt.mockTransaction && tt.mockTransactionErr == nil {
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionScheduled &&
+							events[0].AggregateID == tt.mockTransactionResponse.ID
+					})).Return(nil).Once()
 			}
 
 			result, err := s.ScheduleTransaction(context.Background(), tt.request)
@@ -196,6 +289,13 @@ func TestService_ScheduleTransaction(t *testing.T) {
 }
 
 func TestService_GetTransactions(t *testing.T) {
+	defaultPage := &common.PageQuery{Limit: common.DefaultPageLimit, Sort: common.Sort{Field: "id"}}
+	minAmount := decimal.NewFromInt(1)
+	createdStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	scheduled := func(day int) time.Time {
+		return time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)
+	}
+
 	tests := []struct {
 		name           string
 		request        *request.GetTransactionsParams
@@ -203,7 +303,7 @@ func TestService_GetTransactions(t *testing.T) {
 		mockService    bool
 		mockReturn     []*transactionentity.Transaction
 		mockError      error
-		expectedResult []*transactionentity.Transaction
+		expectedResult *common.Page[*transactionentity.Transaction]
 		expectedError  error
 	}{
 		{
@@ -214,6 +314,8 @@ func TestService_GetTransactions(t *testing.T) {
 				SourceWalletID:      nil,
 				DestinationWalletID: nil,
 				Status:              nil,
+				AssetName:           []string{},
+				Page:                defaultPage,
 			},
 			mockService: true,
 			mockReturn: []*transactionentity.Transaction{
@@ -221,10 +323,10 @@ func TestService_GetTransactions(t *testing.T) {
 				{ID: 2, SourceWalletID: 1001, DestinationWalletID: 1003, Status: "pending"},
 			},
 			mockError: nil,
-			expectedResult: []*transactionentity.Transaction{
+			expectedResult: &common.Page[*transactionentity.Transaction]{Items: []*transactionentity.Transaction{
 				{ID: 1, SourceWalletID: 1001, DestinationWalletID: 1002, Status: "completed"},
 				{ID: 2, SourceWalletID: 1001, DestinationWalletID: 1003, Status: "pending"},
-			},
+			}, Limit: common.DefaultPageLimit},
 			expectedError: nil,
 		},
 		{
@@ -238,15 +340,17 @@ func TestService_GetTransactions(t *testing.T) {
 				SourceWalletID:      []uint{1001},
 				DestinationWalletID: nil,
 				Status:              []string{"pending"},
+				AssetName:           []string{},
+				Page:                defaultPage,
 			},
 			mockService: true,
 			mockReturn: []*transactionentity.Transaction{
 				{ID: 2, SourceWalletID: 1001, DestinationWalletID: 1003, Status: "pending"},
 			},
 			mockError: nil,
-			expectedResult: []*transactionentity.Transaction{
+			expectedResult: &common.Page[*transactionentity.Transaction]{Items: []*transactionentity.Transaction{
 				{ID: 2, SourceWalletID: 1001, DestinationWalletID: 1003, Status: "pending"},
-			},
+			}, Limit: common.DefaultPageLimit},
 			expectedError: nil,
 		},
 		{
@@ -259,6 +363,8 @@ func TestService_GetTransactions(t *testing.T) {
 				SourceWalletID:      []uint{1001},
 				DestinationWalletID: nil,
 				Status:              nil,
+				AssetName:           []string{},
+				Page:                defaultPage,
 			},
 			mockService:    true,
 			mockReturn:     nil,
@@ -266,14 +372,49 @@ func TestService_GetTransactions(t *testing.T) {
 			expectedResult: nil,
 			expectedError:  errors.New("repository error"),
 		},
+		{
+			name: "when more transactions match than the limit then should return the cursor of the next page",
+			request: &request.GetTransactionsParams{
+				AssetName:    []string{"btc"},
+				MinAmount:    &minAmount,
+				CreatedStart: createdStart,
+				Limit:        2,
+				Sort:         "-scheduled_at",
+			},
+			mockFilters: transactionentity.Filters{
+				AssetName:    []string{"BTC"},
+				MinAmount:    &minAmount,
+				CreatedStart: createdStart,
+				Page:         &common.PageQuery{Limit: 2, Sort: common.Sort{Field: "scheduled_at", Desc: true}},
+			},
+			mockService: true,
+			mockReturn: []*transactionentity.Transaction{
+				{ID: 5, AssetName: "BTC", ScheduledAt: scheduled(3)},
+				{ID: 4, AssetName: "BTC", ScheduledAt: scheduled(2)},
+				{ID: 3, AssetName: "BTC", ScheduledAt: scheduled(2)},
+			},
+			expectedResult: &common.Page[*transactionentity.Transaction]{Items: []*transactionentity.Transaction{
+				{ID: 5, AssetName: "BTC", ScheduledAt: scheduled(3)},
+				{ID: 4, AssetName: "BTC", ScheduledAt: scheduled(2)},
+			}, Limit: 2, NextCursor: common.Cursor{Sort: "-scheduled_at", Value: "2026-10-02T00:00:00Z", ID: 4}.Encode()},
+		},
+		{
+			name:          "when cursor was issued for another sort then should return invalid cursor error",
+			request:       &request.GetTransactionsParams{Sort: "amount", Cursor: common.Cursor{Sort: "id", ID: 1}.Encode()},
+			expectedError: common.ErrInvalidCursor,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAssetRepo := assetmock.NewMockAssetRepository(t)
 			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockAssetRepo, mockTransactionRepo, mockWalletClient)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 
 			if tt.mockService {
 				mockTransactionRepo.EXPECT().GetTransactions(mock.Anything, tt.mockFilters).
@@ -293,56 +434,130 @@ func TestService_GetTransactions(t *testing.T) {
 	}
 }
 
+func TestService_GetTransaction(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockReturn     []*transactionentity.Transaction
+		mockError      error
+		expectedResult *transactionentity.Transaction
+		expectedError  error
+	}{
+		{
+			name:           "when transaction exists then should return it",
+			mockReturn:     []*transactionentity.Transaction{{ID: 1, Status: transactionentity.TransactionPending}},
+			expectedResult: &transactionentity.Transaction{ID: 1, Status: transactionentity.TransactionPending},
+		},
+		{
+			name:          "when transaction does not exist then should return not found error",
+			mockReturn:    []*transactionentity.Transaction{},
+			expectedError: ErrTransactionNotFound,
+		},
+		{
+			name:          "when repository error then should return error",
+			mockError:     errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			s := NewService(assetmock.NewMockAssetRepository(t), assetmock.NewMockAssetService(t),
+				mockTransactionRepo, outboxmock.NewMockOutboxRepository(t), catalogmock.NewMockCatalogService(t),
+				walletmock.NewMockWalletClient(t), walletpkg.NewRules(nil))
+
+			mockTransactionRepo.EXPECT().GetTransactions(mock.Anything, transactionentity.Filters{ID: []uint{1}}).
+				Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.GetTransaction(context.Background(), 1)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
 func TestService_CancelTransaction(t *testing.T) {
 	tests := []struct {
 		name                       string
 		transactionID              uint
-		mockGetTransaction         bool
-		mockGetTransactionError    error
-		mockGetTransactionReturn   []*transactionentity.Transaction
+		mockLockError              error
+		mockLockReturn             *transactionentity.Transaction
+		mockReleaseHold            bool
+		mockReleaseHoldError       error
 		mockUpdateTransaction      bool
 		mockUpdateTransactionError error
 		expectedError              error
 	}{
 		{
-			name:               "when transaction is pending then should cancel",
-			transactionID:      1,
-			mockGetTransaction: true,
-			mockGetTransactionReturn: []*transactionentity.Transaction{
-				{ID: 1, Status: transactionentity.TransactionPending},
+			name:          "when transaction is pending then should cancel and release its hold",
+			transactionID: 1,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, SourceWalletID: 1, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+				Status: transactionentity.TransactionPending, Held: true,
 			},
+			mockReleaseHold:       true,
 			mockUpdateTransaction: true,
 			expectedError:         nil,
 		},
 		{
-			name:                     "when transaction is not found then should return error",
-			transactionID:            2,
-			mockGetTransaction:       true,
-			mockGetTransactionReturn: []*transactionentity.Transaction{},
-			expectedError:            ErrTransactionNotFound,
+			name:          "when transaction has no hold then should cancel without releasing",
+			transactionID: 1,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending,
+			},
+			mockUpdateTransaction: true,
+			expectedError:         nil,
+		},
+		{
+			name:          "when transaction is not found then should return error",
+			transactionID: 2,
+			mockLockError: ErrTransactionNotFound,
+			expectedError: ErrTransactionNotFound,
 		},
 		{
-			name:               "when transaction is not pending then should return error",
-			transactionID:      3,
-			mockGetTransaction: true,
-			mockGetTransactionReturn: []*transactionentity.Transaction{
-				{ID: 1, Status: transactionentity.TransactionCompleted},
+			name:          "when transaction is not pending then should return error",
+			transactionID: 3,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionCompleted,
 			},
 			expectedError: ErrTransactionCannotBeDeleted,
 		},
 		{
-			name:                    "when repository error on get then should return error",
-			transactionID:           4,
-			mockGetTransaction:      true,
-			mockGetTransactionError: errors.New("transaction not found"),
-			expectedError:           errors.New("transaction not found"),
+			name:          "when transaction is being executed then should return error",
+			transactionID: 3,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending, ClaimedBy: null.StringFrom("scheduler"),
+				ClaimExpiresAt: null.TimeFrom(time.Now().Add(time.Minute)),
+			},
+			expectedError: ErrTransactionCannotBeDeleted,
 		},
 		{
-			name:               "when repository error on update then should return error",
-			transactionID:      5,
-			mockGetTransaction: true,
-			mockGetTransactionReturn: []*transactionentity.Transaction{
-				{ID: 1, Status: transactionentity.TransactionPending},
+			name:          "when repository error on lock then should return error",
+			transactionID: 4,
+			mockLockError: errors.New("lock error"),
+			expectedError: errors.New("lock error"),
+		},
+		{
+			name:          "when hold cannot be released then should return error",
+			transactionID: 5,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending, Held: true,
+			},
+			mockReleaseHold:      true,
+			mockReleaseHoldError: asset.ErrInsufficientHold,
+			expectedError:        asset.ErrInsufficientHold,
+		},
+		{
+			name:          "when repository error on update then should return error",
+			transactionID: 5,
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending,
 			},
 			mockUpdateTransaction:      true,
 			mockUpdateTransactionError: errors.New("update error"),
@@ -354,19 +569,41 @@ func TestService_CancelTransaction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAssetRepo := assetmock.NewMockAssetRepository(t)
 			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
 			mockWalletClient := walletmock.NewMockWalletClient(t)
-			s := NewService(mockAssetRepo, mockTransactionRepo, mockWalletClient)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
 
-			if tt.mockGetTransaction {
-				mockTransactionRepo.EXPECT().
-					GetTransactions(mock.Anything, transactionentity.Filters{ID: []uint{tt.transactionID}}).
-					Return(tt.mockGetTransactionReturn, tt.mockGetTransactionError).
-					Once()
+			mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockTransactionRepo.EXPECT().LockTransaction(mock.Anything, (*gorm.DB)(nil), tt.transactionID).
+				Return(tt.mockLockReturn, tt.mockLockError).Once()
+
+			if tt.mockReleaseHold {
+				mockAssetService.EXPECT().ReleaseHold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+					WalletID: tt.mockLockReturn.SourceWalletID,
+					Name:     tt.mockLockReturn.AssetName,
+					Amount:   tt.mockLockReturn.Amount,
+				}).Return(&entity.Asset{}, tt.mockReleaseHoldError).Once()
 			}
 
 			if tt.mockUpdateTransaction {
-				mockTransactionRepo.EXPECT().UpdateTransaction(mock.Anything, mock.Anything, mock.Anything).
-					Return(tt.mockUpdateTransactionError).Once()
+				mockTransactionRepo.EXPECT().UpdateTransaction(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(item *transactionentity.Transaction) bool {
+						return item.Status == transactionentity.TransactionCancelled && !item.Held
+					})).Return(tt.mockUpdateTransactionError).Once()
+			}
+
+			if tt.mockUpdateTransaction && tt.mockUpdateTransactionError == nil {
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionCancelled &&
+							events[0].AggregateID == tt.mockLockReturn.ID
+					})).Return(nil).Once()
 			}
 
 			err := s.CancelTransaction(context.Background(), tt.transactionID)
@@ -380,3 +617,818 @@ func TestService_CancelTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Transfer(t *testing.T) {
+	transferRequest := &request.TransferRequest{
+		SourceWalletID:      1,
+		DestinationWalletID: 2,
+		AssetName:           "btc",
+		Amount:              decimal.NewFromInt(5),
+	}
+	reference := ledgerentity.Reference{Type: ledgerentity.ReferenceTransfer, ID: "7"}
+
+	tests := []struct {
+		name              string
+		mockDefinitionErr error
+		mockDestWallet    *walletentity.Wallet
+		mockCreate        bool
+		mockCreateErr     error
+		mockTransfer      bool
+		mockTransferErr   error
+		expectedResult    *transactionentity.Transaction
+		expectedError     error
+	}{
+		{
+			name:         "when balances allow the transfer then should move the funds and return the transaction",
+			mockCreate:   true,
+			mockTransfer: true,
+			expectedResult: &transactionentity.Transaction{
+				ID: 7, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+				Status: transactionentity.TransactionCompleted,
+			},
+		},
+		{
+			name:              "when asset is unknown then should return error",
+			mockDefinitionErr: catalog.ErrUnknownAsset,
+			expectedError:     catalog.ErrUnknownAsset,
+		},
+		{
+			name:           "when destination wallet is deleted then should return error",
+			mockDestWallet: &walletentity.Wallet{ID: 2, DeletedAt: null.TimeFrom(time.Now())},
+			expectedError:  walletpkg.ErrWalletDeleted,
+		},
+		{
+			name:           "when wallets are on different networks then should return error",
+			mockDestWallet: &walletentity.Wallet{ID: 2, Network: "ethereum"},
+			expectedError:  walletpkg.ErrNetworkMismatch,
+		},
+		{
+			name:          "when transaction cannot be created then should return error",
+			mockCreate:    true,
+			mockCreateErr: errors.New("create error"),
+			expectedError: errors.New("create error"),
+		},
+		{
+			name:            "when source balance is insufficient then should return error",
+			mockCreate:      true,
+			mockTransfer:    true,
+			mockTransferErr: asset.ErrInsufficientBalance,
+			expectedError:   ErrInsufficientBalance,
+		},
+		{
+			name:            "when funds cannot be moved then should return error",
+			mockCreate:      true,
+			mockTransfer:    true,
+			mockTransferErr: errors.New("destination wallet not found"),
+			expectedError:   errors.New("destination wallet not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockWalletClient := walletmock.NewMockWalletClient(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(assetmock.NewMockAssetRepository(t), mockAssetService, mockTransactionRepo, mockOutboxRepo,
+				mockCatalogService, mockWalletClient, walletpkg.NewRules(nil))
+
+			mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "btc", transferRequest.Amount).
+				Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true},
+					tt.mockDefinitionErr).Once()
+
+			if tt.mockDefinitionErr == nil {
+				destination := tt.mockDestWallet
+				if destination == nil {
+					destination = &walletentity.Wallet{ID: 2}
+				}
+				mockWalletClient.EXPECT().GetWallets(mock.Anything, []uint{1, 2}).
+					Return(map[uint]*walletentity.Wallet{1: {ID: 1}, 2: destination}, nil).Once()
+			}
+
+			if tt.mockCreate {
+				mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockTransactionRepo.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil),
+					&transactionentity.Transaction{
+						SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+						Status: transactionentity.TransactionCompleted, ScheduledAt: now,
+					}).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB,
+						item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+						if tt.mockCreateErr != nil {
+							return nil, tt.mockCreateErr
+						}
+						item.ID = 7
+						item.ScheduledAt = time.Time{}
+						return item, nil
+					}).Once()
+			}
+
+			if tt.mockTransfer {
+				mockAssetService.EXPECT().Transfer(mock.Anything, (*gorm.DB)(nil), &assetrequest.TransferRequest{
+					SourceWalletID: 1, DestinationWalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(5),
+					Reference: reference, SourceWallet: &walletentity.Wallet{ID: 1},
+					DestinationWallet: &walletentity.Wallet{ID: 2},
+				}).Return(tt.mockTransferErr).Once()
+			}
+
+			if tt.mockTransfer && tt.mockTransferErr == nil {
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionCompleted &&
+							events[0].AggregateID == 7
+					})).Return(nil).Once()
+			}
+
+			result, err := s.Transfer(context.Background(), transferRequest)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_TransferBatch(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	newRequest := func(scheduledAt *time.Time) *request.BatchTransferRequest {
+		return &request.BatchTransferRequest{
+			Legs: []request.BatchTransferLeg{
+				{SourceWalletID: 1, DestinationWalletID: 2, AssetName: "btc", Amount: decimal.NewFromInt(1)},
+				{SourceWalletID: 1, DestinationWalletID: 3, AssetName: "btc", Amount: decimal.NewFromInt(2)},
+			},
+			ScheduledAt: scheduledAt,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		request           *request.BatchTransferRequest
+		mockDefinitionErr error
+		mockMissingWallet bool
+		mockNetworks      map[uint]string
+		mockAssets        []*entity.Asset
+		mockExecute       bool
+		mockHold          bool
+		mockTransferErr   error
+		expectedStatus    transactionentity.TransactionStatus
+		expectedError     error
+		expectedMessage   string
+		expectedDetails   apperror.Details
+	}{
+		{
+			name:           "when balances allow every leg then should execute the batch immediately",
+			request:        newRequest(nil),
+			mockAssets:     []*entity.Asset{{WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(3)}},
+			mockExecute:    true,
+			expectedStatus: transactionentity.TransactionCompleted,
+		},
+		{
+			name:           "when batch is scheduled in the future then should hold the amounts of every leg",
+			request:        newRequest(&later),
+			mockAssets:     []*entity.Asset{{WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(3)}},
+			mockHold:       true,
+			expectedStatus: transactionentity.TransactionPending,
+		},
+		{
+			name:              "when asset of a leg is unknown then should return error naming the leg",
+			request:           newRequest(nil),
+			mockDefinitionErr: catalog.ErrUnknownAsset,
+			expectedError:     catalog.ErrUnknownAsset,
+			expectedMessage:   "leg 0: unknown asset",
+			expectedDetails:   apperror.Details{"leg": 0, "source_wallet_id": uint(1), "destination_wallet_id": uint(2)},
+		},
+		{
+			name:              "when a wallet does not exist then should return error naming the wallet",
+			request:           newRequest(nil),
+			mockMissingWallet: true,
+			expectedError:     walletpkg.ErrWalletNotFound,
+			expectedMessage:   "wallet 1",
+		},
+		{
+			name:            "when the wallets of a leg are on different networks then should return error naming the leg",
+			request:         newRequest(nil),
+			mockNetworks:    map[uint]string{1: "bitcoin", 2: "bitcoin", 3: "ethereum"},
+			expectedError:   walletpkg.ErrNetworkMismatch,
+			expectedMessage: "leg 1",
+			expectedDetails: apperror.Details{"leg": 1, "source_wallet_id": uint(1), "destination_wallet_id": uint(3)},
+		},
+		{
+			name:    "when the total of a source is above its available balance then should return error",
+			request: newRequest(nil),
+			mockAssets: []*entity.Asset{
+				{WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(4), Held: decimal.NewFromInt(2)},
+			},
+			expectedError:   ErrInsufficientBalance,
+			expectedMessage: "wallet 1 BTC: insufficient balance",
+			expectedDetails: apperror.Details{"wallet_id": uint(1), "asset_name": "BTC"},
+		},
+		{
+			name:            "when a leg fails during execution then should return error naming the leg",
+			request:         newRequest(nil),
+			mockAssets:      []*entity.Asset{{WalletID: 1, Name: "BTC", Amount: decimal.NewFromInt(3)}},
+			mockExecute:     true,
+			mockTransferErr: asset.ErrInsufficientBalance,
+			expectedError:   ErrInsufficientBalance,
+			expectedMessage: "leg 1: insufficient balance",
+			expectedDetails: apperror.Details{"leg": 1, "source_wallet_id": uint(1), "destination_wallet_id": uint(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			mockAssetRepo := assetmock.NewMockAssetRepository(t)
+			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			mockWalletClient := walletmock.NewMockWalletClient(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
+
+			if tt.mockDefinitionErr != nil {
+				mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "btc", mock.Anything).
+					Return(nil, tt.mockDefinitionErr).Once()
+			} else {
+				mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "btc", mock.Anything).
+					Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true}, nil).Twice()
+
+				wallets := map[uint]*walletentity.Wallet{}
+				for _, id := range []uint{1, 2, 3} {
+					if id != 1 || !tt.mockMissingWallet {
+						wallets[id] = &walletentity.Wallet{ID: id, Network: tt.mockNetworks[id]}
+					}
+				}
+				mockWalletClient.EXPECT().GetWallets(mock.Anything, []uint{1, 2, 3}).Return(wallets, nil).Once()
+
+				if tt.mockAssets != nil {
+					mockAssetRepo.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+						Name: []string{"BTC"}, WalletID: []uint{1},
+					}).Return(tt.mockAssets, nil).Once()
+				}
+			}
+
+			if tt.mockExecute || tt.mockHold {
+				mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockTransactionRepo.EXPECT().CreateBatch(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB,
+						batch *transactionentity.Batch) (*transactionentity.Batch, error) {
+						batch.ID = 5
+						return batch, nil
+					}).Once()
+
+				var nextID uint = 10
+				mockTransactionRepo.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB,
+						item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+						nextID++
+						item.ID = nextID
+						return item, nil
+					}).Times(2)
+			}
+
+			if tt.mockHold {
+				mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					Return(&entity.Asset{}, nil).Times(2)
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionScheduled
+					})).Return(nil).Times(2)
+			}
+
+			// The wallets validated for the whole batch are passed on instead of being looked up again per leg
+			resolved := mock.MatchedBy(func(req *assetrequest.TransferRequest) bool {
+				return req.SourceWallet != nil && req.SourceWallet.ID == req.SourceWalletID &&
+					req.DestinationWallet != nil && req.DestinationWallet.ID == req.DestinationWalletID
+			})
+
+			if tt.mockExecute {
+				mockAssetService.EXPECT().Transfer(mock.Anything, (*gorm.DB)(nil), resolved).Return(nil).Once()
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionCompleted &&
+							events[0].AggregateID == 11
+					})).Return(nil).Once()
+
+				if tt.mockTransferErr != nil {
+					mockAssetService.EXPECT().Transfer(mock.Anything, (*gorm.DB)(nil), resolved).
+						Return(tt.mockTransferErr).Once()
+				} else {
+					mockAssetService.EXPECT().Transfer(mock.Anything, (*gorm.DB)(nil), resolved).Return(nil).Once()
+					mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+						mock.MatchedBy(func(events []*outboxentity.Event) bool {
+							return len(events) == 1 && events[0].Type == outboxentity.TransactionCompleted &&
+								events[0].AggregateID == 12
+						})).Return(nil).Once()
+				}
+			}
+
+			result, err := s.TransferBatch(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Contains(t, err.Error(), tt.expectedMessage)
+				for key, value := range tt.expectedDetails {
+					assert.Equal(t, value, apperror.NewProblem(err).Details[key], key)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(5), result.ID)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.Len(t, result.Legs, 2)
+			for i, leg := range result.Legs {
+				assert.Equal(t, uint(11+i), leg.ID)
+				assert.Equal(t, int64(5), leg.BatchID.Int64)
+				assert.Equal(t, tt.expectedStatus, leg.Status)
+				assert.Equal(t, "BTC", leg.AssetName)
+				assert.Equal(t, tt.mockHold, leg.Held)
+				assert.Equal(t, result.ScheduledAt, leg.ScheduledAt)
+			}
+		})
+	}
+}
+
+func TestService_ReverseTransaction(t *testing.T) {
+	completed := func(reversed int64) *transactionentity.Transaction {
+		return &transactionentity.Transaction{
+			ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(10),
+			ReversedAmount: decimal.NewFromInt(reversed), Status: transactionentity.TransactionCompleted,
+		}
+	}
+	amount := func(value int64) *decimal.Decimal {
+		d := decimal.NewFromInt(value)
+		return &d
+	}
+
+	tests := []struct {
+		name             string
+		request          *request.ReverseTransactionRequest
+		mockLockReturn   *transactionentity.Transaction
+		mockLockErr      error
+		mockGetAsset     bool
+		mockAssets       []*entity.Asset
+		mockReverse      bool
+		expectedAmount   decimal.Decimal
+		expectedReversed decimal.Decimal
+		expectedStatus   transactionentity.TransactionStatus
+		expectedError    error
+	}{
+		{
+			name:             "when destination has the whole amount then should fully reverse the transaction",
+			request:          &request.ReverseTransactionRequest{},
+			mockLockReturn:   completed(0),
+			mockGetAsset:     true,
+			mockAssets:       []*entity.Asset{{WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(15)}},
+			mockReverse:      true,
+			expectedAmount:   decimal.NewFromInt(10),
+			expectedReversed: decimal.NewFromInt(10),
+			expectedStatus:   transactionentity.TransactionReversed,
+		},
+		{
+			name:             "when an amount is given then should reverse only that amount",
+			request:          &request.ReverseTransactionRequest{Amount: amount(4)},
+			mockLockReturn:   completed(0),
+			mockGetAsset:     true,
+			mockAssets:       []*entity.Asset{{WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(15)}},
+			mockReverse:      true,
+			expectedAmount:   decimal.NewFromInt(4),
+			expectedReversed: decimal.NewFromInt(4),
+			expectedStatus:   transactionentity.TransactionCompleted,
+		},
+		{
+			name:             "when the rest of a partly reversed transaction is reversed then should mark it reversed",
+			request:          &request.ReverseTransactionRequest{},
+			mockLockReturn:   completed(4),
+			mockGetAsset:     true,
+			mockAssets:       []*entity.Asset{{WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(15)}},
+			mockReverse:      true,
+			expectedAmount:   decimal.NewFromInt(6),
+			expectedReversed: decimal.NewFromInt(10),
+			expectedStatus:   transactionentity.TransactionReversed,
+		},
+		{
+			name:           "when destination lacks the amount and partial reversal is allowed then should reverse the rest",
+			request:        &request.ReverseTransactionRequest{AllowPartial: true},
+			mockLockReturn: completed(0),
+			mockGetAsset:   true,
+			mockAssets: []*entity.Asset{
+				{WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(8), Held: decimal.NewFromInt(5)},
+			},
+			mockReverse:      true,
+			expectedAmount:   decimal.NewFromInt(3),
+			expectedReversed: decimal.NewFromInt(3),
+			expectedStatus:   transactionentity.TransactionCompleted,
+		},
+		{
+			name:           "when destination lacks the amount then should return error",
+			request:        &request.ReverseTransactionRequest{},
+			mockLockReturn: completed(0),
+			mockGetAsset:   true,
+			mockAssets:     []*entity.Asset{{WalletID: 2, Name: "BTC", Amount: decimal.NewFromInt(3)}},
+			expectedError:  ErrInsufficientBalance,
+		},
+		{
+			name:           "when destination has nothing left then should return error even if partial is allowed",
+			request:        &request.ReverseTransactionRequest{AllowPartial: true},
+			mockLockReturn: completed(0),
+			mockGetAsset:   true,
+			expectedError:  ErrInsufficientBalance,
+		},
+		{
+			name:           "when amount exceeds what is left to reverse then should return error",
+			request:        &request.ReverseTransactionRequest{Amount: amount(7)},
+			mockLockReturn: completed(4),
+			expectedError:  ErrReversalExceedsAmount,
+		},
+		{
+			name:    "when transaction is pending then should return error",
+			request: &request.ReverseTransactionRequest{},
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 1, Amount: decimal.NewFromInt(10), Status: transactionentity.TransactionPending,
+			},
+			expectedError: ErrTransactionCannotBeReversed,
+		},
+		{
+			name:    "when transaction is a reversal then should return error",
+			request: &request.ReverseTransactionRequest{},
+			mockLockReturn: &transactionentity.Transaction{
+				ID: 2, Amount: decimal.NewFromInt(10), Status: transactionentity.TransactionCompleted,
+				ReversalOf: null.IntFrom(1),
+			},
+			expectedError: ErrTransactionCannotBeReversed,
+		},
+		{
+			name:          "when transaction is not found then should return error",
+			request:       &request.ReverseTransactionRequest{},
+			mockLockErr:   ErrTransactionNotFound,
+			expectedError: ErrTransactionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAssetRepo := assetmock.NewMockAssetRepository(t)
+			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo,
+				catalogmock.NewMockCatalogService(t), walletmock.NewMockWalletClient(t), walletpkg.NewRules(nil))
+
+			mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+					return fn(nil)
+				}).Once()
+			mockTransactionRepo.EXPECT().LockTransaction(mock.Anything, (*gorm.DB)(nil), uint(1)).
+				Return(tt.mockLockReturn, tt.mockLockErr).Once()
+
+			if tt.mockGetAsset {
+				mockAssetRepo.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+					Name: []string{"BTC"}, WalletID: []uint{2},
+				}).Return(tt.mockAssets, nil).Once()
+			}
+
+			if tt.mockReverse {
+				reference := ledgerentity.Reference{Type: ledgerentity.ReferenceReversal, ID: "2"}
+
+				mockTransactionRepo.EXPECT().CreateTransaction(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(item *transactionentity.Transaction) bool {
+						return item.SourceWalletID == 2 && item.DestinationWalletID == 1 &&
+							item.Amount.Equal(tt.expectedAmount) && item.ReversalOf.Int64 == 1 &&
+							item.Status == transactionentity.TransactionCompleted
+					})).
+					RunAndReturn(func(_ context.Context, _ *gorm.DB,
+						item *transactionentity.Transaction) (*transactionentity.Transaction, error) {
+						item.ID = 2
+						return item, nil
+					}).Once()
+				mockAssetService.EXPECT().Transfer(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(req *assetrequest.TransferRequest) bool {
+						return req.SourceWalletID == 2 && req.DestinationWalletID == 1 &&
+							req.Amount.Equal(tt.expectedAmount) && req.Reference == reference
+					})).Return(nil).Once()
+				mockOutboxRepo.EXPECT().CreateEvents(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(events []*outboxentity.Event) bool {
+						return len(events) == 1 && events[0].Type == outboxentity.TransactionCompleted &&
+							events[0].AggregateID == 2
+					})).Return(nil).Once()
+				mockTransactionRepo.EXPECT().UpdateTransaction(mock.Anything, (*gorm.DB)(nil),
+					mock.MatchedBy(func(item *transactionentity.Transaction) bool {
+						return item.ID == 1 && item.ReversedAmount.Equal(tt.expectedReversed) &&
+							item.Status == tt.expectedStatus
+					})).Return(nil).Once()
+			}
+
+			result, err := s.ReverseTransaction(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(2), result.ID)
+				assert.True(t, tt.expectedAmount.Equal(result.Amount))
+			}
+		})
+	}
+}
+
+func TestService_AmendTransaction(t *testing.T) {
+	pending := func() *transactionentity.Transaction {
+		return &transactionentity.Transaction{
+			ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+			Status: transactionentity.TransactionPending, Held: true,
+		}
+	}
+	amount := func(value int64) *decimal.Decimal {
+		d := decimal.NewFromInt(value)
+		return &d
+	}
+	wallet := func(id uint) *uint {
+		return &id
+	}
+	scheduledAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		request            *request.AmendTransactionRequest
+		mockTransaction    *transactionentity.Transaction
+		mockNotFound       bool
+		mockLocked         *transactionentity.Transaction
+		mockValidate       bool
+		mockDefinitionErr  error
+		mockWallets        []uint
+		mockMissingWallet  bool
+		mockAssets         []*entity.Asset
+		mockHold           *decimal.Decimal
+		mockHoldErr        error
+		mockRelease        *decimal.Decimal
+		mockUpdate         bool
+		expectedError      error
+		expectedAmount     decimal.Decimal
+		expectedDest       uint
+		expectedScheduleAt time.Time
+	}{
+		{
+			name:               "when only the scheduled time changes then should keep the hold",
+			request:            &request.AmendTransactionRequest{ScheduledAt: &scheduledAt},
+			mockTransaction:    pending(),
+			mockValidate:       true,
+			mockWallets:        []uint{1, 2},
+			mockAssets:         []*entity.Asset{{WalletID: 1}, {WalletID: 2}},
+			mockUpdate:         true,
+			expectedAmount:     decimal.NewFromInt(5),
+			expectedDest:       2,
+			expectedScheduleAt: scheduledAt,
+		},
+		{
+			name:            "when the amount increases then should hold the difference",
+			request:         &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: pending(),
+			mockValidate:    true,
+			mockWallets:     []uint{1, 2},
+			mockAssets:      []*entity.Asset{{WalletID: 1}, {WalletID: 2}},
+			mockHold:        amount(3),
+			mockUpdate:      true,
+			expectedAmount:  decimal.NewFromInt(8),
+			expectedDest:    2,
+		},
+		{
+			name:            "when the amount decreases then should release the difference",
+			request:         &request.AmendTransactionRequest{Amount: amount(2), DestinationWalletID: wallet(3)},
+			mockTransaction: pending(),
+			mockValidate:    true,
+			mockWallets:     []uint{1, 3},
+			mockAssets:      []*entity.Asset{{WalletID: 1}, {WalletID: 3}},
+			mockRelease:     amount(3),
+			mockUpdate:      true,
+			expectedAmount:  decimal.NewFromInt(2),
+			expectedDest:    3,
+		},
+		{
+			name:            "when the increase is not available then should return error",
+			request:         &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: pending(),
+			mockValidate:    true,
+			mockWallets:     []uint{1, 2},
+			mockAssets:      []*entity.Asset{{WalletID: 1}, {WalletID: 2}},
+			mockHold:        amount(3),
+			mockHoldErr:     asset.ErrInsufficientBalance,
+			expectedError:   ErrInsufficientBalance,
+		},
+		{
+			name:    "when an unheld transaction is amended then should check the available balance",
+			request: &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: &transactionentity.Transaction{
+				ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+				Status: transactionentity.TransactionPending,
+			},
+			mockValidate: true,
+			mockWallets:  []uint{1, 2},
+			mockAssets: []*entity.Asset{
+				{WalletID: 1, Amount: decimal.NewFromInt(7)}, {WalletID: 2},
+			},
+			expectedError: ErrInsufficientBalance,
+		},
+		{
+			name:            "when the new destination does not hold the asset then should return error",
+			request:         &request.AmendTransactionRequest{DestinationWalletID: wallet(3)},
+			mockTransaction: pending(),
+			mockValidate:    true,
+			mockWallets:     []uint{1, 3},
+			mockAssets:      []*entity.Asset{{WalletID: 1}},
+			expectedError:   ErrAssetNotFound,
+		},
+		{
+			name:              "when the new destination does not exist then should return error",
+			request:           &request.AmendTransactionRequest{DestinationWalletID: wallet(3)},
+			mockTransaction:   pending(),
+			mockValidate:      true,
+			mockWallets:       []uint{1, 3},
+			mockMissingWallet: true,
+			expectedError:     walletpkg.ErrWalletNotFound,
+		},
+		{
+			name:              "when the amount is too precise then should return error",
+			request:           &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction:   pending(),
+			mockValidate:      true,
+			mockDefinitionErr: catalog.ErrAmountPrecision,
+			expectedError:     catalog.ErrAmountPrecision,
+		},
+		{
+			name:            "when the new destination is the source then should return error",
+			request:         &request.AmendTransactionRequest{DestinationWalletID: wallet(1)},
+			mockTransaction: pending(),
+			expectedError:   ErrSameWallet,
+		},
+		{
+			name:    "when transaction is not pending then should return error",
+			request: &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionCompleted,
+			},
+			expectedError: ErrTransactionCannotBeAmended,
+		},
+		{
+			name:    "when transaction is claimed by the scheduler then should return error",
+			request: &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending, ClaimedBy: null.StringFrom("scheduler"),
+				ClaimExpiresAt: null.TimeFrom(time.Now().Add(time.Minute)),
+			},
+			expectedError: ErrTransactionCannotBeAmended,
+		},
+		{
+			name:    "when transaction is a batch leg then should return error",
+			request: &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: &transactionentity.Transaction{
+				ID: 1, Status: transactionentity.TransactionPending, BatchID: null.IntFrom(4),
+			},
+			expectedError: ErrTransactionCannotBeAmended,
+		},
+		{
+			name:            "when transaction is claimed after it was validated then should return error",
+			request:         &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: pending(),
+			mockLocked: &transactionentity.Transaction{
+				ID: 1, SourceWalletID: 1, DestinationWalletID: 2, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+				Status: transactionentity.TransactionPending, Held: true, ClaimedBy: null.StringFrom("scheduler"),
+				ClaimExpiresAt: null.TimeFrom(time.Now().Add(time.Minute)),
+			},
+			mockValidate:  true,
+			mockWallets:   []uint{1, 2},
+			expectedError: ErrTransactionCannotBeAmended,
+		},
+		{
+			name:            "when transaction is amended concurrently after it was validated then should return error",
+			request:         &request.AmendTransactionRequest{Amount: amount(8)},
+			mockTransaction: pending(),
+			mockLocked: &transactionentity.Transaction{
+				ID: 1, SourceWalletID: 1, DestinationWalletID: 3, AssetName: "BTC", Amount: decimal.NewFromInt(5),
+				Status: transactionentity.TransactionPending, Held: true,
+			},
+			mockValidate:  true,
+			mockWallets:   []uint{1, 2},
+			expectedError: ErrConcurrentAmendment,
+		},
+		{
+			name:          "when transaction is not found then should return error",
+			request:       &request.AmendTransactionRequest{Amount: amount(8)},
+			mockNotFound:  true,
+			expectedError: ErrTransactionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAssetRepo := assetmock.NewMockAssetRepository(t)
+			mockTransactionRepo := transactionmock.NewMockTransactionRepository(t)
+			mockCatalogService := catalogmock.NewMockCatalogService(t)
+			mockWalletClient := walletmock.NewMockWalletClient(t)
+			mockAssetService := assetmock.NewMockAssetService(t)
+			mockOutboxRepo := outboxmock.NewMockOutboxRepository(t)
+			s := NewService(mockAssetRepo, mockAssetService, mockTransactionRepo, mockOutboxRepo, mockCatalogService,
+				mockWalletClient, walletpkg.NewRules(nil))
+
+			var found []*transactionentity.Transaction
+			if !tt.mockNotFound {
+				found = append(found, tt.mockTransaction)
+			}
+			mockTransactionRepo.EXPECT().GetTransactions(mock.Anything, transactionentity.Filters{ID: []uint{1}}).
+				Return(found, nil).Once()
+
+			// The transaction is only locked once the wallets were validated
+			if tt.mockWallets != nil && !tt.mockMissingWallet {
+				locked := tt.mockLocked
+				if locked == nil {
+					copied := *tt.mockTransaction
+					locked = &copied
+				}
+				mockTransactionRepo.EXPECT().InTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, fn func(tx *gorm.DB) error) error {
+						return fn(nil)
+					}).Once()
+				mockTransactionRepo.EXPECT().LockTransaction(mock.Anything, (*gorm.DB)(nil), uint(1)).
+					Return(locked, nil).Once()
+			}
+
+			if tt.mockValidate {
+				mockCatalogService.EXPECT().ValidateAmount(mock.Anything, "BTC", mock.Anything).
+					Return(&catalogentity.AssetDefinition{Symbol: "BTC", Decimals: 8, Enabled: true},
+						tt.mockDefinitionErr).Once()
+			}
+
+			if tt.mockWallets != nil {
+				wallets := map[uint]*walletentity.Wallet{}
+				for i, id := range tt.mockWallets {
+					if i < len(tt.mockWallets)-1 || !tt.mockMissingWallet {
+						wallets[id] = &walletentity.Wallet{ID: id}
+					}
+				}
+				mockWalletClient.EXPECT().GetWallets(mock.Anything, tt.mockWallets).Return(wallets, nil).Once()
+			}
+
+			if tt.mockAssets != nil {
+				mockAssetRepo.EXPECT().GetAsset(mock.Anything, (*gorm.DB)(nil), entity.Filters{
+					Name: []string{"BTC"}, WalletID: tt.mockWallets,
+				}).Return(tt.mockAssets, nil).Once()
+			}
+
+			if tt.mockHold != nil {
+				mockAssetService.EXPECT().Hold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+					WalletID: 1, Name: "BTC", Amount: *tt.mockHold,
+				}).Return(&entity.Asset{}, tt.mockHoldErr).Once()
+			}
+
+			if tt.mockRelease != nil {
+				mockAssetService.EXPECT().ReleaseHold(mock.Anything, (*gorm.DB)(nil), &assetrequest.HoldRequest{
+					WalletID: 1, Name: "BTC", Amount: *tt.mockRelease,
+				}).Return(&entity.Asset{}, nil).Once()
+			}
+
+			if tt.mockUpdate {
+				mockTransactionRepo.EXPECT().UpdateTransaction(mock.Anything, (*gorm.DB)(nil), mock.Anything).
+					Return(nil).Once()
+			}
+
+			result, err := s.AmendTransaction(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tt.expectedAmount.Equal(result.Amount))
+			assert.Equal(t, tt.expectedDest, result.DestinationWalletID)
+			assert.Equal(t, tt.expectedScheduleAt, result.ScheduledAt)
+			assert.True(t, result.Held)
+			assert.True(t, result.UpdatedAt.Valid)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package walletcache
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"net/http"
+)
+
+// Handler exposes the invalidation hook the wallet service calls when a wallet changed, so that the change is seen
+// before the cached wallet expires
+type Handler struct {
+	walletCache wallet.CachedClient
+}
+
+// NewHandler initializes a new Handler instance with the provided wallet cache
+func NewHandler(walletCache wallet.CachedClient) *Handler {
+	return &Handler{walletCache: walletCache}
+}
+
+// RegisterRoutes registers the wallet cache API routes with the provided Echo router group
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.DELETE("/wallets/cache", h.InvalidateAll)
+	e.DELETE("/wallets/:id/cache", h.InvalidateWallet)
+}
+
+// InvalidateWallet handles requests to drop a single cached wallet
+func (h Handler) InvalidateWallet(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	h.walletCache.Invalidate(id)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// InvalidateAll handles requests to drop every cached wallet
+func (h Handler) InvalidateAll(ctx echo.Context) error {
+	h.walletCache.InvalidateAll()
+
+	return ctx.NoContent(http.StatusNoContent)
+}
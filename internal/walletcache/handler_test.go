@@ -0,0 +1,60 @@
+package walletcache
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	walletmock "github.com/safayildirim/asset-management-service/pkg/client/wallet/mock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_RegisterRoutes(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		mock           func(mockCache *walletmock.MockCachedClient)
+		expectedStatus int
+	}{
+		{
+			name: "when a wallet is invalidated then should drop it from the cache",
+			path: "/api/wallets/7/cache",
+			mock: func(mockCache *walletmock.MockCachedClient) {
+				mockCache.EXPECT().Invalidate(uint(7)).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "when the whole cache is invalidated then should drop every wallet",
+			path: "/api/wallets/cache",
+			mock: func(mockCache *walletmock.MockCachedClient) {
+				mockCache.EXPECT().InvalidateAll().Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "when the wallet ID is invalid then should respond bad request",
+			path:           "/api/wallets/abc/cache",
+			mock:           func(mockCache *walletmock.MockCachedClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCache := walletmock.NewMockCachedClient(t)
+			tt.mock(mockCache)
+
+			e := echo.New()
+			e.HTTPErrorHandler = apperror.HTTPErrorHandler
+			NewHandler(mockCache).RegisterRoutes(e.Group("/api"))
+
+			req := httptest.NewRequest(http.MethodDelete, tt.path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
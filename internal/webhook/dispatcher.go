@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	"github.com/safayildirim/asset-management-service/internal/worker"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v3"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+var (
+	// errSubscriptionInactive fails the deliveries left over when their subscription was deactivated
+	errSubscriptionInactive = errors.New("webhook subscription is inactive")
+	// errAddressNotPublic fails an attempt whose URL resolves to an address of the internal network
+	errAddressNotPublic = errors.New("webhook receiver address is not public")
+)
+
+// Dispatcher posts pending deliveries to their subscriptions. Deliveries are leased to the dispatcher before being
+// attempted, so several instances can run concurrently, and a delivery is retried with exponential backoff until the
+// receiver acknowledges it or the attempts are exhausted.
+type Dispatcher struct {
+	id         string
+	cfg        config.WebhookConfig
+	repository Repository
+	client     *http.Client
+	backoff    worker.Backoff
+}
+
+// NewDispatcher initializes a new Dispatcher instance delivering the pending deliveries of the repository
+func NewDispatcher(cfg config.WebhookConfig, repository Repository) *Dispatcher {
+	return &Dispatcher{id: worker.NewInstanceID(), cfg: cfg, repository: repository,
+		client: newClient(time.Duration(cfg.Timeout) * time.Second),
+		backoff: worker.NewBackoff(time.Duration(cfg.RetryBaseDelay)*time.Second,
+			time.Duration(cfg.RetryMaxDelay)*time.Second, 0)}
+}
+
+// newClient builds an HTTP client that only connects to public addresses. The address is checked after the host name
+// was resolved, right before connecting, so that a host name validated on subscription cannot be rebound to an
+// internal address later on. Proxies are not used, as the check would apply to the proxy instead of the receiver.
+func newClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !common.IsPublicIP(ip) {
+				return errAddressNotPublic
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// Start runs the dispatcher in a loop until the context is cancelled, logging errors and keeping running so that a
+// temporary database outage does not stop it
+func (d *Dispatcher) Start(ctx context.Context) {
+	log.Logger.Info("webhook dispatcher started", zap.String("id", d.id))
+
+	ticker := time.NewTicker(time.Duration(d.cfg.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		_, err := d.RunOnce(ctx)
+		if err != nil {
+			log.Logger.Error("failed to run webhook dispatcher", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Logger.Info("webhook dispatcher stopped", zap.String("id", d.id))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims a batch of due deliveries and attempts each of them once.
+//
+// Returns:
+// - The number of deliveries acknowledged by their receiver in this run.
+// - An error if the due deliveries or their subscriptions could not be read.
+func (d *Dispatcher) RunOnce(ctx context.Context) (int, error) {
+	deliveries, err := d.repository.ClaimDueDeliveries(ctx, d.id, common.Now(),
+		time.Duration(d.cfg.LeaseDuration)*time.Second, d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	// Fetch the subscriptions of the claimed deliveries at once
+	ids := make([]uint, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.SubscriptionID)
+	}
+
+	subscriptions, err := d.repository.GetSubscriptions(ctx, entity.Filters{ID: ids})
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptionsByID := make(map[uint]*entity.Subscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionsByID[subscription.ID] = subscription
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		err = d.attempt(ctx, delivery, subscriptionsByID[delivery.SubscriptionID])
+		switch {
+		case err == nil:
+			delivered++
+		case errors.Is(err, ErrClaimLost):
+			// Another instance claimed the delivery after the lease expired, so it is attempted again
+			log.Logger.Warn("webhook delivery claim lost", zap.Uint("id", delivery.ID))
+		default:
+			log.Logger.Error("failed to deliver webhook", zap.Uint("id", delivery.ID),
+				zap.Int("attempts", delivery.Attempts), zap.Error(err))
+		}
+	}
+
+	return delivered, nil
+}
+
+// attempt posts a claimed delivery to its subscription and records the attempt. A failed delivery is scheduled for
+// another attempt, unless its attempts are exhausted or its subscription is inactive; the delivery error is returned
+// once that is recorded.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *entity.Delivery, subscription *entity.Subscription) error {
+	started := time.Now()
+	now := common.Now()
+
+	var (
+		status     int
+		deliverErr error
+	)
+	if subscription == nil || !subscription.Active {
+		deliverErr = errSubscriptionInactive
+	} else {
+		status, deliverErr = d.send(ctx, delivery, subscription)
+	}
+
+	attempt := &entity.Attempt{DeliveryID: delivery.ID, DurationMs: time.Since(started).Milliseconds()}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = null.TimeFrom(now)
+	delivery.UpdatedAt = null.TimeFrom(now)
+	delivery.ResponseStatus = null.NewInt(int64(status), status != 0)
+	attempt.ResponseStatus = delivery.ResponseStatus
+
+	switch {
+	case deliverErr == nil:
+		delivery.Status = entity.DeliverySucceeded
+		delivery.DeliveredAt = null.TimeFrom(now)
+		delivery.NextAttemptAt = null.Time{}
+		delivery.LastError = null.String{}
+	case errors.Is(deliverErr, errSubscriptionInactive) || delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.Status = entity.DeliveryFailed
+		delivery.NextAttemptAt = null.Time{}
+		delivery.LastError = null.StringFrom(deliverErr.Error())
+	default:
+		delivery.NextAttemptAt = null.TimeFrom(now.Add(d.backoff.NextDelay(delivery.Attempts)))
+		delivery.LastError = null.StringFrom(deliverErr.Error())
+	}
+	attempt.Error = delivery.LastError
+
+	err := d.repository.RecordAttempt(ctx, delivery, attempt, d.id, common.Now())
+	if err != nil {
+		return err
+	}
+
+	return deliverErr
+}
+
+// send posts the payload of a delivery to the URL of its subscription, signed with the secret of the subscription.
+//
+// Returns:
+// - The status of the response, or 0 if no response was received.
+// - An error if no response was received or its status is not 2xx.
+func (d *Dispatcher) send(ctx context.Context, delivery *entity.Delivery, subscription *entity.Subscription) (int,
+	error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := common.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, strconv.FormatUint(uint64(delivery.EventID), 10))
+	req.Header.Set(HeaderEventType, string(delivery.EventType))
+	req.Header.Set(HeaderDeliveryID, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if errors.Is(err, errAddressNotPublic) {
+		return 0, errAddressNotPublic
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the response, so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/asset-management-service/internal/webhook/mock"
+	"github.com/safayildirim/asset-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef"
+
+func TestDispatcher_RunOnce(t *testing.T) {
+	cfg := config.WebhookConfig{Interval: 1, LeaseDuration: 60, BatchSize: 10, Timeout: 5, MaxAttempts: 10,
+		RetryBaseDelay: 30, RetryMaxDelay: 3600}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	payload := outboxentity.Payload(`{"id":7,"type":"asset.deposited"}`)
+
+	tests := []struct {
+		name              string
+		receiverStatus    int
+		inactive          bool
+		guarded           bool
+		delivery          *entity.Delivery
+		mockClaimErr      error
+		expectedRequests  int
+		expectedDelivered int
+		expectedError     error
+		expectedDelivery  *entity.Delivery
+		expectedAttempt   *entity.Attempt
+	}{
+		{
+			name:              "when the receiver acknowledges the delivery then should mark it succeeded",
+			receiverStatus:    http.StatusNoContent,
+			delivery:          &entity.Delivery{ID: 3, SubscriptionID: 1, Attempts: 1},
+			expectedRequests:  1,
+			expectedDelivered: 1,
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliverySucceeded,
+				Attempts: 2, ResponseStatus: null.IntFrom(http.StatusNoContent), DeliveredAt: null.TimeFrom(now)},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3, ResponseStatus: null.IntFrom(http.StatusNoContent)},
+		},
+		{
+			name:             "when the receiver fails then should schedule another attempt with backoff",
+			receiverStatus:   http.StatusInternalServerError,
+			delivery:         &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 2},
+			expectedRequests: 1,
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 3,
+				ResponseStatus: null.IntFrom(http.StatusInternalServerError),
+				LastError:      null.StringFrom("receiver responded with status 500"),
+				NextAttemptAt:  null.TimeFrom(now.Add(2 * time.Minute))},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3,
+				ResponseStatus: null.IntFrom(http.StatusInternalServerError),
+				Error:          null.StringFrom("receiver responded with status 500")},
+		},
+		{
+			name:             "when the backoff exceeds the maximum delay then should cap it",
+			receiverStatus:   http.StatusBadGateway,
+			delivery:         &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 8},
+			expectedRequests: 1,
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 9,
+				ResponseStatus: null.IntFrom(http.StatusBadGateway),
+				LastError:      null.StringFrom("receiver responded with status 502"),
+				NextAttemptAt:  null.TimeFrom(now.Add(time.Hour))},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3, ResponseStatus: null.IntFrom(http.StatusBadGateway),
+				Error: null.StringFrom("receiver responded with status 502")},
+		},
+		{
+			name:             "when the attempts are exhausted then should mark the delivery failed",
+			receiverStatus:   http.StatusInternalServerError,
+			delivery:         &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 9},
+			expectedRequests: 1,
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryFailed, Attempts: 10,
+				ResponseStatus: null.IntFrom(http.StatusInternalServerError),
+				LastError:      null.StringFrom("receiver responded with status 500")},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3,
+				ResponseStatus: null.IntFrom(http.StatusInternalServerError),
+				Error:          null.StringFrom("receiver responded with status 500")},
+		},
+		{
+			name:     "when the receiver resolves to an internal address then should refuse to connect",
+			guarded:  true,
+			delivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 1},
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending, Attempts: 2,
+				LastError:     null.StringFrom("webhook receiver address is not public"),
+				NextAttemptAt: null.TimeFrom(now.Add(time.Minute))},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3,
+				Error: null.StringFrom("webhook receiver address is not public")},
+		},
+		{
+			name:     "when the subscription is inactive then should fail the delivery without posting it",
+			inactive: true,
+			delivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryPending},
+			expectedDelivery: &entity.Delivery{ID: 3, SubscriptionID: 1, Status: entity.DeliveryFailed, Attempts: 1,
+				LastError: null.StringFrom("webhook subscription is inactive")},
+			expectedAttempt: &entity.Attempt{DeliveryID: 3, Error: null.StringFrom("webhook subscription is inactive")},
+		},
+		{
+			name:          "when due deliveries cannot be claimed then should return error",
+			mockClaimErr:  errors.New("connection refused"),
+			expectedError: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			requests := 0
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := io.ReadAll(r.Body)
+				timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, now.Unix(), timestamp)
+				assert.Equal(t, "7", r.Header.Get(HeaderEventID))
+				assert.Equal(t, "asset.deposited", r.Header.Get(HeaderEventType))
+				assert.Equal(t, "3", r.Header.Get(HeaderDeliveryID))
+				assert.Equal(t, Sign(testSecret, timestamp, body), r.Header.Get(HeaderSignature))
+				assert.JSONEq(t, string(payload), string(body))
+
+				w.WriteHeader(tt.receiverStatus)
+			}))
+			defer receiver.Close()
+
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			dispatcher := NewDispatcher(cfg, mockRepository)
+			if !tt.guarded {
+				// The test receiver listens on a loopback address, which the dispatcher refuses to connect to
+				dispatcher.client = receiver.Client()
+			}
+
+			var deliveries []*entity.Delivery
+			if tt.delivery != nil {
+				tt.delivery.EventID = 7
+				tt.delivery.EventType = outboxentity.Deposited
+				tt.delivery.Payload = payload
+				deliveries = append(deliveries, tt.delivery)
+			}
+
+			mockRepository.EXPECT().ClaimDueDeliveries(mock.Anything, dispatcher.id, now, time.Minute, 10).
+				Return(deliveries, tt.mockClaimErr).Once()
+
+			var recorded *entity.Attempt
+			if tt.delivery != nil {
+				subscription := &entity.Subscription{ID: 1, URL: receiver.URL, Secret: testSecret, Active: !tt.inactive}
+				mockRepository.EXPECT().GetSubscriptions(mock.Anything, entity.Filters{ID: []uint{1}}).
+					Return([]*entity.Subscription{subscription}, nil).Once()
+				mockRepository.EXPECT().RecordAttempt(mock.Anything, tt.delivery, mock.Anything, dispatcher.id, now).
+					RunAndReturn(func(_ context.Context, _ *entity.Delivery, attempt *entity.Attempt, _ string,
+						_ time.Time) error {
+						recorded = attempt
+						return nil
+					}).Once()
+			}
+
+			count, err := dispatcher.RunOnce(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDelivered, count)
+			assert.Equal(t, tt.expectedRequests, requests)
+
+			// The payload, event and timing fields are not part of the expectations
+			tt.expectedDelivery.EventID = 7
+			tt.expectedDelivery.EventType = outboxentity.Deposited
+			tt.expectedDelivery.Payload = payload
+			tt.expectedDelivery.LastAttemptAt = null.TimeFrom(now)
+			tt.expectedDelivery.UpdatedAt = null.TimeFrom(now)
+			assert.Equal(t, tt.expectedDelivery, tt.delivery)
+
+			recorded.DurationMs = 0
+			assert.Equal(t, tt.expectedAttempt, recorded)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", 1700000000, []byte(`{"id":1}`))
+
+	assert.Equal(t, "sha256=3dd1b9aef568d75f6790a84bd2e5dfa1f44409eef3cbdbd3f10b837376100c11", signature)
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Delivery is an event on its way to a subscription. It is attempted until the receiver acknowledges it with a 2xx
+// response or the attempts are exhausted.
+type Delivery struct {
+	ID             uint                   `json:"id"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      null.Time              `json:"updated_at"`
+	SubscriptionID uint                   `json:"subscription_id"`
+	EventID        uint                   `json:"event_id"`
+	EventType      outboxentity.EventType `json:"event_type"`
+	// Payload is the body posted to the subscription, i.e. the event encoded as JSON
+	Payload        outboxentity.Payload `json:"payload"`
+	Status         DeliveryStatus       `json:"status"`
+	Attempts       int                  `json:"attempts"`
+	LastAttemptAt  null.Time            `json:"last_attempt_at"`
+	NextAttemptAt  null.Time            `json:"next_attempt_at"`
+	ResponseStatus null.Int             `json:"response_status"`
+	LastError      null.String          `json:"last_error"`
+	DeliveredAt    null.Time            `json:"delivered_at"`
+	ClaimedBy      null.String          `json:"-"`
+	ClaimExpiresAt null.Time            `json:"-"`
+	// History lists the attempts made so far, oldest first; it is only loaded for a single delivery
+	History []*Attempt `json:"history,omitempty" gorm:"-"`
+}
+
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Attempt records the outcome of a single attempt to deliver an event
+type Attempt struct {
+	ID             uint        `json:"id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	DeliveryID     uint        `json:"delivery_id"`
+	ResponseStatus null.Int    `json:"response_status"`
+	Error          null.String `json:"error"`
+	DurationMs     int64       `json:"duration_ms"`
+}
+
+func (Attempt) TableName() string {
+	return "webhook_delivery_attempts"
+}
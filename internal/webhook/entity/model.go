@@ -0,0 +1,14 @@
+package entity
+
+import "gopkg.in/guregu/null.v3"
+
+type Filters struct {
+	ID     []uint
+	Active null.Bool
+}
+
+type DeliveryFilters struct {
+	ID             []uint
+	SubscriptionID []uint
+	Status         []string
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"github.com/lib/pq"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"gopkg.in/guregu/null.v3"
+	"slices"
+	"time"
+)
+
+// Subscription registers a URL that the events of the outbox are pushed to. Events are signed with the secret of the
+// subscription, so that the receiver can verify they were sent by this service.
+type Subscription struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt null.Time `json:"updated_at"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	// EventTypes restricts the subscription to events of these types; it receives every event when it is empty
+	EventTypes pq.StringArray `json:"event_types"`
+	// WalletIDs restricts the subscription to events involving one of these wallets; it receives the events of every
+	// wallet when it is empty
+	WalletIDs pq.Int64Array `json:"wallet_ids"`
+	Active    bool          `json:"active"`
+}
+
+func (Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Matches reports whether an event of the given type involving the given wallets is delivered to the subscription
+func (s *Subscription) Matches(eventType outboxentity.EventType, walletIDs []uint) bool {
+	if !s.Active {
+		return false
+	}
+
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, string(eventType)) {
+		return false
+	}
+
+	if len(s.WalletIDs) == 0 {
+		return true
+	}
+	for _, id := range walletIDs {
+		if slices.Contains(s.WalletIDs, int64(id)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrSubscriptionNotFound = apperror.New(apperror.CodeSubscriptionNotFound, http.StatusNotFound,
+		"webhook subscription not found")
+	ErrDeliveryNotFound = apperror.New(apperror.CodeDeliveryNotFound, http.StatusNotFound,
+		"webhook delivery not found")
+	ErrDeliveryInProgress = apperror.New(apperror.CodeDeliveryInProgress, http.StatusConflict,
+		"webhook delivery is being attempted right now")
+
+	// ErrClaimLost is internal to the dispatcher and never reaches a client
+	ErrClaimLost = errors.New("webhook delivery is no longer claimed by this dispatcher")
+)
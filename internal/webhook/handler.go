@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"github.com/gorilla/schema"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/webhook/request"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+var decoder = schema.NewDecoder()
+
+func init() {
+	decoder.RegisterConverter([]string{}, func(value string) reflect.Value {
+		return reflect.ValueOf(strings.Split(value, ","))
+	})
+}
+
+type Handler struct {
+	webhookService Service
+}
+
+// NewHandler initializes a new Handler instance with the provided webhook service
+func NewHandler(webhookService Service) *Handler {
+	return &Handler{webhookService: webhookService}
+}
+
+// RegisterRoutes registers the webhook API routes with the provided Echo router group
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/webhooks", h.CreateSubscription)
+	e.GET("/webhooks", h.GetSubscriptions)
+	e.GET("/webhooks/:id", h.GetSubscription)
+	e.PATCH("/webhooks/:id", h.UpdateSubscription)
+	e.DELETE("/webhooks/:id", h.DeleteSubscription)
+	e.GET("/webhooks/:id/deliveries", h.GetDeliveries)
+	e.GET("/webhook-deliveries/:id", h.GetDelivery)
+	e.POST("/webhook-deliveries/:id/redeliver", h.RedeliverDelivery)
+}
+
+// CreateSubscription handles requests to subscribe a URL to events
+func (h Handler) CreateSubscription(ctx echo.Context) error {
+	var req request.CreateSubscriptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, common.Response{Data: subscription})
+}
+
+// GetSubscriptions handles requests to list webhook subscriptions
+func (h Handler) GetSubscriptions(ctx echo.Context) error {
+	var req request.GetSubscriptionsParams
+	params := ctx.QueryParams()
+
+	err := decoder.Decode(&req, params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscriptions, err := h.webhookService.GetSubscriptions(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: subscriptions})
+}
+
+// GetSubscription handles requests to fetch a single webhook subscription by ID
+func (h Handler) GetSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := h.webhookService.GetSubscription(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: subscription})
+}
+
+// UpdateSubscription handles requests to change a webhook subscription
+func (h Handler) UpdateSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var req request.UpdateSubscriptionRequest
+	if err = ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err = req.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := h.webhookService.UpdateSubscription(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: subscription})
+}
+
+// DeleteSubscription handles requests to remove a webhook subscription
+func (h Handler) DeleteSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = h.webhookService.DeleteSubscription(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetDeliveries handles requests to list the deliveries of a webhook subscription
+func (h Handler) GetDeliveries(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var req request.GetDeliveriesParams
+	err = decoder.Decode(&req, ctx.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	deliveries, err := h.webhookService.GetDeliveries(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: deliveries})
+}
+
+// GetDelivery handles requests to fetch a single webhook delivery with the history of its attempts
+func (h Handler) GetDelivery(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	delivery, err := h.webhookService.GetDelivery(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, common.Response{Data: delivery})
+}
+
+// RedeliverDelivery handles requests to queue a webhook delivery for another attempt
+func (h Handler) RedeliverDelivery(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	delivery, err := h.webhookService.RedeliverDelivery(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusAccepted, common.Response{Data: delivery})
+}
@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/asset-management-service/internal/webhook/mock"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_CreateSubscription(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Subscription
+		mockError            error
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name: "when valid request body is provided then should create subscription",
+			body: `{"url":"https://partner.example.com/hooks","secret":"0123456789abcdef",` +
+				`"event_types":["transaction.completed"],"wallet_ids":[10]}`,
+			mockService: true,
+			mockReturn:  &entity.Subscription{ID: 1, URL: "https://partner.example.com/hooks", Active: true},
+		},
+		{
+			name:                 "when url is not an http url then should return bad request",
+			body:                 `{"url":"ftp://partner.example.com/hooks","secret":"0123456789abcdef"}`,
+			expectErr:            true,
+			expectedErrorMessage: "url: must be an absolute http or https URL",
+		},
+		{
+			name:                 "when url points to a loopback address then should return bad request",
+			body:                 `{"url":"http://127.0.0.1:8080/hooks","secret":"0123456789abcdef"}`,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a loopback, private or link-local address",
+		},
+		{
+			name:                 "when url points to localhost then should return bad request",
+			body:                 `{"url":"http://localhost/hooks","secret":"0123456789abcdef"}`,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a loopback, private or link-local address",
+		},
+		{
+			name:                 "when url points to the metadata address then should return bad request",
+			body:                 `{"url":"http://169.254.169.254/latest/meta-data","secret":"0123456789abcdef"}`,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a loopback, private or link-local address",
+		},
+		{
+			name:                 "when url points to a private address then should return bad request",
+			body:                 `{"url":"https://[fd00::1]/hooks","secret":"0123456789abcdef"}`,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a loopback, private or link-local address",
+		},
+		{
+			name:                 "when secret is too short then should return bad request",
+			body:                 `{"url":"https://partner.example.com/hooks","secret":"short"}`,
+			expectErr:            true,
+			expectedErrorMessage: "secret: the length must be no less than 16",
+		},
+		{
+			name: "when event type is unknown then should return bad request",
+			body: `{"url":"https://partner.example.com/hooks","secret":"0123456789abcdef",` +
+				`"event_types":["asset.burned"]}`,
+			expectErr:            true,
+			expectedErrorMessage: "event_types: (0: must be a valid value.)",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"url":"https://partner.example.com/hooks","secret":"0123456789abcdef"}`,
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectErr:            true,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := webhookmock.NewMockWebhookService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateSubscription(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateSubscription(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+				assert.NotContains(t, rec.Body.String(), "secret")
+			}
+		})
+	}
+}
+
+func TestHandler_RedeliverDelivery(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name           string
+		id             string
+		mockService    bool
+		mockReturn     *entity.Delivery
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "when delivery exists then should accept the redelivery",
+			id:             "3",
+			mockService:    true,
+			mockReturn:     &entity.Delivery{ID: 3, Status: entity.DeliveryPending},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "when id is invalid then should return bad request",
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "when delivery does not exist then should return not found",
+			id:             "3",
+			mockService:    true,
+			mockError:      ErrDeliveryNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when delivery is being attempted then should return conflict",
+			id:             "3",
+			mockService:    true,
+			mockError:      ErrDeliveryInProgress,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := webhookmock.NewMockWebhookService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().RedeliverDelivery(mock.Anything, uint(3)).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/webhook-deliveries/:id/redeliver")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.id)
+
+			err := handler.RedeliverDelivery(ctx)
+
+			if tt.expectedStatus >= http.StatusBadRequest {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, apperror.NewProblem(err).Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	"gopkg.in/guregu/null.v3"
+)
+
+// Publisher is the outbox publisher that hands events over to the webhook subscriptions. It only stores a delivery
+// per matching subscription; the dispatcher posts the deliveries, so a slow receiver never holds up the relay.
+type Publisher struct {
+	repository Repository
+}
+
+// NewPublisher initializes a new Publisher storing the deliveries of published events in the repository
+func NewPublisher(repository Repository) *Publisher {
+	return &Publisher{repository: repository}
+}
+
+// Publish creates a pending delivery of the event for every active subscription it matches
+func (p *Publisher) Publish(ctx context.Context, event *outboxentity.Event) error {
+	subscriptions, err := p.repository.GetSubscriptions(ctx, entity.Filters{Active: null.BoolFrom(true)})
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %d: %w", event.ID, err)
+	}
+
+	walletIDs, err := event.WalletIDs()
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*entity.Delivery
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type, walletIDs) {
+			continue
+		}
+
+		deliveries = append(deliveries, &entity.Delivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        body,
+			Status:         entity.DeliveryPending,
+		})
+	}
+
+	return p.repository.CreateDeliveries(ctx, deliveries)
+}
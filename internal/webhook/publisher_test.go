@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lib/pq"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/asset-management-service/internal/webhook/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	subscriptions := []*entity.Subscription{
+		{ID: 1, Active: true},
+		{ID: 2, Active: true, EventTypes: pq.StringArray{"transaction.completed"}},
+		{ID: 3, Active: true, WalletIDs: pq.Int64Array{20}},
+		{ID: 4, Active: true, WalletIDs: pq.Int64Array{30}},
+	}
+
+	tests := []struct {
+		name                    string
+		event                   *outboxentity.Event
+		expectedSubscriptionIDs []uint
+	}{
+		{
+			name: "when an asset event is published then should deliver it to the subscriptions of its wallet",
+			event: &outboxentity.Event{ID: 5, Type: outboxentity.Deposited,
+				Payload: outboxentity.Payload(`{"asset_id":1,"wallet_id":30}`)},
+			expectedSubscriptionIDs: []uint{1, 4},
+		},
+		{
+			name: "when a transaction event is published then should match its source and destination wallets",
+			event: &outboxentity.Event{ID: 6, Type: outboxentity.TransactionCompleted,
+				Payload: outboxentity.Payload(`{"id":1,"source_wallet_id":10,"destination_wallet_id":20}`)},
+			expectedSubscriptionIDs: []uint{1, 2, 3},
+		},
+		{
+			name: "when the event involves no filtered wallet then should only deliver it to unfiltered subscriptions",
+			event: &outboxentity.Event{ID: 7, Type: outboxentity.Withdrawn,
+				Payload: outboxentity.Payload(`{"asset_id":1,"wallet_id":40}`)},
+			expectedSubscriptionIDs: []uint{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			publisher := NewPublisher(mockRepository)
+
+			mockRepository.EXPECT().GetSubscriptions(mock.Anything, entity.Filters{Active: null.BoolFrom(true)}).
+				Return(subscriptions, nil).Once()
+
+			var created []*entity.Delivery
+			mockRepository.EXPECT().CreateDeliveries(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, deliveries []*entity.Delivery) error {
+					created = deliveries
+					return nil
+				}).Once()
+
+			err := publisher.Publish(context.Background(), tt.event)
+
+			assert.NoError(t, err)
+
+			body, _ := json.Marshal(tt.event)
+			var subscriptionIDs []uint
+			for _, delivery := range created {
+				subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+				assert.Equal(t, tt.event.ID, delivery.EventID)
+				assert.Equal(t, tt.event.Type, delivery.EventType)
+				assert.Equal(t, entity.DeliveryPending, delivery.Status)
+				assert.JSONEq(t, string(body), string(delivery.Payload))
+			}
+			assert.Equal(t, tt.expectedSubscriptionIDs, subscriptionIDs)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"time"
+)
+
+type Repository interface {
+	CreateSubscription(ctx context.Context, item *entity.Subscription) (*entity.Subscription, error)
+	GetSubscriptions(ctx context.Context, filters entity.Filters) ([]*entity.Subscription, error)
+	UpdateSubscription(ctx context.Context, item *entity.Subscription) error
+	DeleteSubscription(ctx context.Context, id uint) error
+	CreateDeliveries(ctx context.Context, deliveries []*entity.Delivery) error
+	GetDeliveries(ctx context.Context, filters entity.DeliveryFilters) ([]*entity.Delivery, error)
+	GetAttempts(ctx context.Context, deliveryID uint) ([]*entity.Attempt, error)
+	UpdateUnclaimedDelivery(ctx context.Context, item *entity.Delivery, now time.Time) error
+	ClaimDueDeliveries(ctx context.Context, owner string, now time.Time, lease time.Duration,
+		limit int) ([]*entity.Delivery, error)
+	RecordAttempt(ctx context.Context, item *entity.Delivery, attempt *entity.Attempt, owner string,
+		now time.Time) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateSubscription(ctx context.Context, item *entity.Subscription) (*entity.Subscription,
+	error) {
+	err := r.db.WithContext(ctx).Create(item).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repository) GetSubscriptions(ctx context.Context, filters entity.Filters) ([]*entity.Subscription, error) {
+	var subscriptions []*entity.Subscription
+
+	query := r.db.WithContext(ctx).Model(&entity.Subscription{})
+
+	if len(filters.ID) > 0 {
+		query = query.Where("id IN ?", filters.ID)
+	}
+	if filters.Active.Valid {
+		query = query.Where("active = ?", filters.Active.Bool)
+	}
+
+	err := query.Order("id").Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *repository) UpdateSubscription(ctx context.Context, item *entity.Subscription) error {
+	err := r.db.WithContext(ctx).Save(item).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSubscription removes a subscription together with its deliveries and their attempts
+func (r *repository) DeleteSubscription(ctx context.Context, id uint) error {
+	err := r.db.WithContext(ctx).Delete(&entity.Subscription{}, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateDeliveries stores new deliveries, skipping those whose event was already delivered to the same subscription,
+// so that publishing an event again does not deliver it twice
+func (r *repository) CreateDeliveries(ctx context.Context, deliveries []*entity.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true}).
+		Create(deliveries).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) GetDeliveries(ctx context.Context, filters entity.DeliveryFilters) ([]*entity.Delivery, error) {
+	var deliveries []*entity.Delivery
+
+	query := r.db.WithContext(ctx).Model(&entity.Delivery{})
+
+	if len(filters.ID) > 0 {
+		query = query.Where("id IN ?", filters.ID)
+	}
+	if len(filters.SubscriptionID) > 0 {
+		query = query.Where("subscription_id IN ?", filters.SubscriptionID)
+	}
+	if len(filters.Status) > 0 {
+		query = query.Where("status IN ?", filters.Status)
+	}
+
+	err := query.Order("id DESC").Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *repository) GetAttempts(ctx context.Context, deliveryID uint) ([]*entity.Attempt, error) {
+	var attempts []*entity.Attempt
+
+	err := r.db.WithContext(ctx).Where("delivery_id = ?", deliveryID).Order("id").Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// UpdateUnclaimedDelivery saves a delivery unless a dispatcher holds an unexpired lease on it, in which case
+// ErrDeliveryInProgress is returned
+func (r *repository) UpdateUnclaimedDelivery(ctx context.Context, item *entity.Delivery, now time.Time) error {
+	result := r.db.WithContext(ctx).Model(item).
+		Where("claim_expires_at IS NULL OR claim_expires_at <= ?", now).
+		Select("*").Omit("id", "created_at").
+		Updates(item)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrDeliveryInProgress
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries leases up to limit pending deliveries to the given owner, in the order they were created.
+// Deliveries waiting for another attempt are only due once their next attempt time has been reached.
+//
+// Like the claims of the scheduler, deliveries whose lease expired are claimed again and rows locked by a concurrent
+// claim are skipped, so any number of dispatcher instances can run at the same time.
+func (r *repository) ClaimDueDeliveries(ctx context.Context, owner string, now time.Time, lease time.Duration,
+	limit int) ([]*entity.Delivery, error) {
+	var deliveries []*entity.Delivery
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries
+		SET claimed_by = ?, claim_expires_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND (claim_expires_at IS NULL OR claim_expires_at <= ?)
+				AND (next_attempt_at IS NULL OR next_attempt_at <= ?)
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		owner, now.Add(lease), entity.DeliveryPending, now, now, limit).Scan(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+
+	return deliveries, nil
+}
+
+// RecordAttempt stores an attempt of a claimed delivery and saves the resulting state of the delivery, releasing its
+// claim, provided the given owner still holds an unexpired lease on it. ErrClaimLost is returned otherwise.
+func (r *repository) RecordAttempt(ctx context.Context, item *entity.Delivery, attempt *entity.Attempt, owner string,
+	now time.Time) error {
+	item.ClaimedBy = null.String{}
+	item.ClaimExpiresAt = null.Time{}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(item).Where("claimed_by = ? AND claim_expires_at > ?", owner, now).
+			Select("updated_at", "status", "attempts", "last_attempt_at", "next_attempt_at", "response_status",
+				"last_error", "delivered_at", "claimed_by", "claim_expires_at").
+			Updates(item)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrClaimLost
+		}
+
+		return tx.Create(attempt).Error
+	})
+}
@@ -0,0 +1,25 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+type CreateSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+	WalletIDs  []uint   `json:"wallet_ids"`
+	Active     *bool    `json:"active"`
+}
+
+func (r CreateSubscriptionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.URL, validation.Required, httpURL),
+		validation.Field(&r.Secret, validation.Required, validation.Length(MinSecretLength, 0)),
+		validation.Field(&r.EventTypes, eventType),
+		validation.Field(&r.WalletIDs, validation.Each(validation.Required)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook subscription create validation error")
+}
@@ -0,0 +1,25 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+)
+
+type GetSubscriptionsParams struct {
+	ID     []uint `json:"id" schema:"id"`
+	Active *bool  `json:"active" schema:"active"`
+}
+
+type GetDeliveriesParams struct {
+	Status []string `json:"status" schema:"status"`
+}
+
+func (r GetDeliveriesParams) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Status, validation.Each(validation.In(string(entity.DeliveryPending),
+			string(entity.DeliverySucceeded), string(entity.DeliveryFailed)))),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook delivery get validation error")
+}
@@ -0,0 +1,64 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	outboxentity "github.com/safayildirim/asset-management-service/internal/outbox/entity"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// MinSecretLength is the shortest secret accepted for signing deliveries
+const MinSecretLength = 16
+
+// errNonPublicHost rejects URLs that would have the dispatcher post deliveries into the internal network
+var errNonPublicHost = errors.New("must not point to a loopback, private or link-local address")
+
+// httpURL validates that a value is an absolute http or https URL whose host is not a loopback, private or link-local
+// address. Host names are resolved when a delivery is sent, so the dispatcher checks the address it connects to again.
+var httpURL = validation.By(func(value interface{}) error {
+	var raw string
+	switch v := value.(type) {
+	case string:
+		raw = v
+	case *string:
+		if v == nil {
+			return nil
+		}
+		raw = *v
+	}
+
+	if raw == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("must be an absolute http or https URL")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errNonPublicHost
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !common.IsPublicIP(ip) {
+		return errNonPublicHost
+	}
+
+	return nil
+})
+
+// eventType validates that each of the values is a known event type
+var eventType = validation.Each(validation.In(eventTypeValues()...))
+
+func eventTypeValues() []interface{} {
+	values := make([]interface{}, 0, len(outboxentity.EventTypes))
+	for _, eventType := range outboxentity.EventTypes {
+		values = append(values, string(eventType))
+	}
+
+	return values
+}
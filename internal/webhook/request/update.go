@@ -0,0 +1,27 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+// UpdateSubscriptionRequest changes the provided fields of a subscription. Event types and wallet IDs replace the
+// current filters when present; an empty list removes the filter.
+type UpdateSubscriptionRequest struct {
+	URL        *string  `json:"url"`
+	Secret     *string  `json:"secret"`
+	EventTypes []string `json:"event_types"`
+	WalletIDs  []uint   `json:"wallet_ids"`
+	Active     *bool    `json:"active"`
+}
+
+func (r UpdateSubscriptionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.URL, validation.NilOrNotEmpty, httpURL),
+		validation.Field(&r.Secret, validation.NilOrNotEmpty, validation.Length(MinSecretLength, 0)),
+		validation.Field(&r.EventTypes, eventType),
+		validation.Field(&r.WalletIDs, validation.Each(validation.Required)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook subscription update validation error")
+}
@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	"github.com/safayildirim/asset-management-service/internal/webhook/request"
+	"gopkg.in/guregu/null.v3"
+)
+
+type Service interface {
+	CreateSubscription(ctx context.Context, request *request.CreateSubscriptionRequest) (*entity.Subscription, error)
+	GetSubscriptions(ctx context.Context, request *request.GetSubscriptionsParams) ([]*entity.Subscription, error)
+	GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error)
+	UpdateSubscription(ctx context.Context, id uint,
+		request *request.UpdateSubscriptionRequest) (*entity.Subscription, error)
+	DeleteSubscription(ctx context.Context, id uint) error
+	GetDeliveries(ctx context.Context, subscriptionID uint,
+		request *request.GetDeliveriesParams) ([]*entity.Delivery, error)
+	GetDelivery(ctx context.Context, id uint) (*entity.Delivery, error)
+	RedeliverDelivery(ctx context.Context, id uint) (*entity.Delivery, error)
+}
+
+type service struct {
+	webhookRepository Repository
+}
+
+func NewService(webhookRepository Repository) Service {
+	return &service{webhookRepository: webhookRepository}
+}
+
+func (s *service) CreateSubscription(ctx context.Context,
+	request *request.CreateSubscriptionRequest) (*entity.Subscription, error) {
+	active := true
+	if request.Active != nil {
+		active = *request.Active
+	}
+
+	item := entity.Subscription{
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: toStringArray(request.EventTypes),
+		WalletIDs:  toInt64Array(request.WalletIDs),
+		Active:     active,
+	}
+	return s.webhookRepository.CreateSubscription(ctx, &item)
+}
+
+func (s *service) GetSubscriptions(ctx context.Context,
+	request *request.GetSubscriptionsParams) ([]*entity.Subscription, error) {
+	filters := entity.Filters{
+		ID:     request.ID,
+		Active: null.BoolFromPtr(request.Active),
+	}
+	return s.webhookRepository.GetSubscriptions(ctx, filters)
+}
+
+// GetSubscription fetches a single webhook subscription by its ID.
+//
+// Errors:
+//   - ErrSubscriptionNotFound: If no subscription exists with the ID.
+func (s *service) GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error) {
+	subscriptions, err := s.webhookRepository.GetSubscriptions(ctx, entity.Filters{ID: []uint{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return subscriptions[0], nil
+}
+
+// UpdateSubscription applies the provided fields to an existing webhook subscription.
+//
+// Parameters:
+//   - ctx: The context for managing request lifecycle and cancellation.
+//   - id: The ID of the subscription to update.
+//   - request: The fields to change; nil fields are left untouched.
+//
+// Errors:
+//   - ErrSubscriptionNotFound: If no subscription exists with the ID.
+func (s *service) UpdateSubscription(ctx context.Context, id uint,
+	request *request.UpdateSubscriptionRequest) (*entity.Subscription, error) {
+	subscription, err := s.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.URL != nil {
+		subscription.URL = *request.URL
+	}
+	if request.Secret != nil {
+		subscription.Secret = *request.Secret
+	}
+	if request.EventTypes != nil {
+		subscription.EventTypes = toStringArray(request.EventTypes)
+	}
+	if request.WalletIDs != nil {
+		subscription.WalletIDs = toInt64Array(request.WalletIDs)
+	}
+	if request.Active != nil {
+		subscription.Active = *request.Active
+	}
+	subscription.UpdatedAt = null.TimeFrom(common.Now())
+
+	err = s.webhookRepository.UpdateSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription removes a webhook subscription; its pending deliveries are dropped with it.
+//
+// Errors:
+//   - ErrSubscriptionNotFound: If no subscription exists with the ID.
+func (s *service) DeleteSubscription(ctx context.Context, id uint) error {
+	subscription, err := s.GetSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.webhookRepository.DeleteSubscription(ctx, subscription.ID)
+}
+
+// GetDeliveries lists the deliveries of a webhook subscription, most recent first.
+//
+// Errors:
+//   - ErrSubscriptionNotFound: If no subscription exists with the ID.
+func (s *service) GetDeliveries(ctx context.Context, subscriptionID uint,
+	request *request.GetDeliveriesParams) ([]*entity.Delivery, error) {
+	subscription, err := s.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := entity.DeliveryFilters{
+		SubscriptionID: []uint{subscription.ID},
+		Status:         request.Status,
+	}
+	return s.webhookRepository.GetDeliveries(ctx, filters)
+}
+
+// GetDelivery fetches a single webhook delivery by its ID, together with the history of its attempts.
+//
+// Errors:
+//   - ErrDeliveryNotFound: If no delivery exists with the ID.
+func (s *service) GetDelivery(ctx context.Context, id uint) (*entity.Delivery, error) {
+	delivery, err := s.getDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.History, err = s.webhookRepository.GetAttempts(ctx, delivery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// RedeliverDelivery queues a delivery to be attempted again right away, whatever its current status. The attempt
+// counter starts over, so a failed delivery gets the full number of attempts again; its earlier attempts are kept.
+//
+// Errors:
+//   - ErrDeliveryNotFound: If no delivery exists with the ID.
+//   - ErrDeliveryInProgress: If a dispatcher is attempting the delivery at this moment.
+func (s *service) RedeliverDelivery(ctx context.Context, id uint) (*entity.Delivery, error) {
+	delivery, err := s.getDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := common.Now()
+	delivery.Status = entity.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = null.Time{}
+	delivery.DeliveredAt = null.Time{}
+	delivery.ClaimedBy = null.String{}
+	delivery.ClaimExpiresAt = null.Time{}
+	delivery.UpdatedAt = null.TimeFrom(now)
+
+	err = s.webhookRepository.UpdateUnclaimedDelivery(ctx, delivery, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (s *service) getDelivery(ctx context.Context, id uint) (*entity.Delivery, error) {
+	deliveries, err := s.webhookRepository.GetDeliveries(ctx, entity.DeliveryFilters{ID: []uint{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, ErrDeliveryNotFound
+	}
+
+	return deliveries[0], nil
+}
+
+// toStringArray converts the values into an array column, which is empty rather than NULL when there are none
+func toStringArray(values []string) pq.StringArray {
+	return append(pq.StringArray{}, values...)
+}
+
+// toInt64Array converts the IDs into an array column, which is empty rather than NULL when there are none
+func toInt64Array(ids []uint) pq.Int64Array {
+	values := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, int64(id))
+	}
+
+	return values
+}
@@ -0,0 +1,204 @@
+package webhook
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/internal/common"
+	"github.com/safayildirim/asset-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/asset-management-service/internal/webhook/mock"
+	"github.com/safayildirim/asset-management-service/internal/webhook/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+	"time"
+)
+
+func TestService_CreateSubscription(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name         string
+		request      *request.CreateSubscriptionRequest
+		expectedItem *entity.Subscription
+	}{
+		{
+			name: "when filters are provided then should create an active subscription with them",
+			request: &request.CreateSubscriptionRequest{URL: "https://partner.example.com/hooks", Secret: testSecret,
+				EventTypes: []string{"transaction.completed"}, WalletIDs: []uint{10, 20}},
+			expectedItem: &entity.Subscription{URL: "https://partner.example.com/hooks", Secret: testSecret,
+				EventTypes: pq.StringArray{"transaction.completed"}, WalletIDs: pq.Int64Array{10, 20}, Active: true},
+		},
+		{
+			name: "when no filters are provided then should store empty filters",
+			request: &request.CreateSubscriptionRequest{URL: "https://partner.example.com/hooks", Secret: testSecret,
+				Active: &inactive},
+			expectedItem: &entity.Subscription{URL: "https://partner.example.com/hooks", Secret: testSecret,
+				EventTypes: pq.StringArray{}, WalletIDs: pq.Int64Array{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().CreateSubscription(mock.Anything, tt.expectedItem).
+				Return(tt.expectedItem, nil).Once()
+
+			result, err := s.CreateSubscription(context.Background(), tt.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedItem, result)
+		})
+	}
+}
+
+func TestService_UpdateSubscription(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	url := "https://partner.example.com/v2/hooks"
+	inactive := false
+
+	tests := []struct {
+		name              string
+		request           *request.UpdateSubscriptionRequest
+		mockSubscriptions []*entity.Subscription
+		mockUpdate        bool
+		expectedResult    *entity.Subscription
+		expectedError     error
+	}{
+		{
+			name:    "when fields are provided then should update only those fields",
+			request: &request.UpdateSubscriptionRequest{URL: &url, Active: &inactive, WalletIDs: []uint{}},
+			mockSubscriptions: []*entity.Subscription{{ID: 1, URL: "https://partner.example.com/hooks",
+				Secret: testSecret, EventTypes: pq.StringArray{"asset.deposited"}, WalletIDs: pq.Int64Array{10},
+				Active: true}},
+			mockUpdate: true,
+			expectedResult: &entity.Subscription{ID: 1, UpdatedAt: null.TimeFrom(now), URL: url, Secret: testSecret,
+				EventTypes: pq.StringArray{"asset.deposited"}, WalletIDs: pq.Int64Array{}},
+		},
+		{
+			name:              "when subscription does not exist then should return error",
+			request:           &request.UpdateSubscriptionRequest{Active: &inactive},
+			mockSubscriptions: []*entity.Subscription{},
+			expectedError:     ErrSubscriptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetSubscriptions(mock.Anything, entity.Filters{ID: []uint{1}}).
+				Return(tt.mockSubscriptions, nil).Once()
+
+			if tt.mockUpdate {
+				mockRepository.EXPECT().UpdateSubscription(mock.Anything, tt.expectedResult).Return(nil).Once()
+			}
+
+			result, err := s.UpdateSubscription(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_RedeliverDelivery(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockDeliveries []*entity.Delivery
+		mockUpdate     bool
+		mockUpdateErr  error
+		expectedResult *entity.Delivery
+		expectedError  error
+	}{
+		{
+			name: "when a delivery failed then should queue it again with its attempts reset",
+			mockDeliveries: []*entity.Delivery{{ID: 3, Status: entity.DeliveryFailed, Attempts: 10,
+				LastError: null.StringFrom("receiver responded with status 500")}},
+			mockUpdate: true,
+			expectedResult: &entity.Delivery{ID: 3, UpdatedAt: null.TimeFrom(now), Status: entity.DeliveryPending,
+				LastError: null.StringFrom("receiver responded with status 500")},
+		},
+		{
+			name: "when a delivery succeeded then should queue it again",
+			mockDeliveries: []*entity.Delivery{{ID: 3, Status: entity.DeliverySucceeded, Attempts: 1,
+				DeliveredAt: null.TimeFrom(now.Add(-time.Hour))}},
+			mockUpdate:     true,
+			expectedResult: &entity.Delivery{ID: 3, UpdatedAt: null.TimeFrom(now), Status: entity.DeliveryPending},
+		},
+		{
+			name:           "when a dispatcher is attempting the delivery then should return error",
+			mockDeliveries: []*entity.Delivery{{ID: 3, Status: entity.DeliveryPending, Attempts: 1}},
+			mockUpdate:     true,
+			mockUpdateErr:  ErrDeliveryInProgress,
+			expectedError:  ErrDeliveryInProgress,
+		},
+		{
+			name:           "when delivery does not exist then should return error",
+			mockDeliveries: []*entity.Delivery{},
+			expectedError:  ErrDeliveryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.Now = func() time.Time { return now }
+			defer func() { common.Now = time.Now }()
+
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetDeliveries(mock.Anything, entity.DeliveryFilters{ID: []uint{3}}).
+				Return(tt.mockDeliveries, nil).Once()
+
+			if tt.mockUpdate {
+				mockRepository.EXPECT().UpdateUnclaimedDelivery(mock.Anything, mock.Anything, now).
+					Return(tt.mockUpdateErr).Once()
+			}
+
+			result, err := s.RedeliverDelivery(context.Background(), 3)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_GetDelivery(t *testing.T) {
+	mockRepository := webhookmock.NewMockWebhookRepository(t)
+	s := NewService(mockRepository)
+
+	attempts := []*entity.Attempt{{ID: 1, DeliveryID: 3, Error: null.StringFrom("connection refused")}}
+	mockRepository.EXPECT().GetDeliveries(mock.Anything, entity.DeliveryFilters{ID: []uint{3}}).
+		Return([]*entity.Delivery{{ID: 3}}, nil).Once()
+	mockRepository.EXPECT().GetAttempts(mock.Anything, uint(3)).Return(attempts, nil).Once()
+
+	result, err := s.GetDelivery(context.Background(), 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, attempts, result.History)
+
+	mockRepository.EXPECT().GetDeliveries(mock.Anything, entity.DeliveryFilters{ID: []uint{4}}).
+		Return(nil, errors.New("connection refused")).Once()
+
+	_, err = s.GetDelivery(context.Background(), 4)
+
+	assert.EqualError(t, err, "connection refused")
+}
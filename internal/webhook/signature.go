@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers sent along with every delivery
+const (
+	HeaderEventID    = "X-Webhook-Event-Id"
+	HeaderEventType  = "X-Webhook-Event-Type"
+	HeaderDeliveryID = "X-Webhook-Delivery-Id"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+)
+
+// Sign computes the signature of a delivery: the hex encoded HMAC-SHA256 of the timestamp, a dot and the body, keyed
+// with the secret of the subscription. Signing the timestamp lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
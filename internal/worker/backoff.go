@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff decides how long a background worker waits before attempting a failed item again
+type Backoff struct {
+	// BaseDelay is the delay before the first retry; it doubles with every further attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts
+	MaxDelay time.Duration
+	// Jitter is the fraction (0 to 1) by which a delay is randomly shortened or lengthened
+	Jitter float64
+
+	random func() float64
+}
+
+// NewBackoff builds a backoff growing from the base delay up to the maximum delay, with the given jitter
+func NewBackoff(baseDelay, maxDelay time.Duration, jitter float64) Backoff {
+	return Backoff{BaseDelay: baseDelay, MaxDelay: maxDelay, Jitter: jitter, random: rand.Float64}
+}
+
+// NextDelay returns the delay before the attempt following the given number of attempts, growing exponentially from
+// the base delay up to the maximum delay, with jitter applied so that retries of many items spread out.
+func (b Backoff) NextDelay(attempts int) time.Duration {
+	delay := float64(b.BaseDelay) * math.Pow(2, float64(max(attempts-1, 0)))
+	if b.MaxDelay > 0 && delay > float64(b.MaxDelay) {
+		delay = float64(b.MaxDelay)
+	}
+
+	if b.Jitter > 0 && b.random != nil {
+		delay += delay * b.Jitter * (2*b.random() - 1)
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBackoff_NextDelay(t *testing.T) {
+	backoff := NewBackoff(10*time.Second, time.Minute, 0)
+
+	t.Run("when attempts grow then should back off exponentially up to the maximum delay", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, backoff.NextDelay(0))
+		assert.Equal(t, 10*time.Second, backoff.NextDelay(1))
+		assert.Equal(t, 20*time.Second, backoff.NextDelay(2))
+		assert.Equal(t, 40*time.Second, backoff.NextDelay(3))
+		assert.Equal(t, time.Minute, backoff.NextDelay(4))
+		assert.Equal(t, time.Minute, backoff.NextDelay(30))
+	})
+
+	t.Run("when jitter is configured then should keep the delay within the jitter range", func(t *testing.T) {
+		jittered := backoff
+		jittered.Jitter = 0.2
+
+		jittered.random = func() float64 { return 0 }
+		assert.Equal(t, 8*time.Second, jittered.NextDelay(1))
+
+		jittered.random = func() float64 { return 1 }
+		assert.Equal(t, 12*time.Second, jittered.NextDelay(1))
+	})
+}
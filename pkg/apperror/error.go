@@ -0,0 +1,149 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Code is a stable, machine-readable identifier of an error that clients can branch on
+type Code string
+
+const (
+	CodeInvalidRequest           Code = "INVALID_REQUEST"
+	CodeNotFound                 Code = "NOT_FOUND"
+	CodeMethodNotAllowed         Code = "METHOD_NOT_ALLOWED"
+	CodeConflict                 Code = "CONFLICT"
+	CodeUnprocessable            Code = "UNPROCESSABLE_ENTITY"
+	CodeTooManyRequests          Code = "TOO_MANY_REQUESTS"
+	CodeServiceUnavailable       Code = "SERVICE_UNAVAILABLE"
+	CodeInternal                 Code = "INTERNAL_ERROR"
+	CodeAssetNotFound            Code = "ASSET_NOT_FOUND"
+	CodeAssetAlreadyExists       Code = "ASSET_ALREADY_EXISTS"
+	CodeUnknownAsset             Code = "UNKNOWN_ASSET"
+	CodeAssetDisabled            Code = "ASSET_DISABLED"
+	CodeAmountPrecision          Code = "AMOUNT_PRECISION_EXCEEDED"
+	CodeAmountBelowMinimum       Code = "AMOUNT_BELOW_MINIMUM"
+	CodeAssetDefinitionNotFound  Code = "ASSET_DEFINITION_NOT_FOUND"
+	CodeAssetDefinitionExists    Code = "ASSET_DEFINITION_ALREADY_EXISTS"
+	CodeAssetDefinitionInUse     Code = "ASSET_DEFINITION_IN_USE"
+	CodeDecimalsBelowStoredScale Code = "DECIMALS_BELOW_STORED_SCALE"
+	CodeInsufficientBalance      Code = "INSUFFICIENT_BALANCE"
+	CodeInsufficientHold         Code = "INSUFFICIENT_HOLD"
+	CodeConcurrentUpdate         Code = "CONCURRENT_UPDATE"
+	CodeTransactionNotFound      Code = "TRANSACTION_NOT_FOUND"
+	CodeTransactionNotCancelable Code = "TRANSACTION_NOT_CANCELABLE"
+	CodeTransactionNotReversible Code = "TRANSACTION_NOT_REVERSIBLE"
+	CodeTransactionNotAmendable  Code = "TRANSACTION_NOT_AMENDABLE"
+	CodeReversalExceedsAmount    Code = "REVERSAL_EXCEEDS_AMOUNT"
+	CodeSameWallet               Code = "SAME_WALLET"
+	CodeWalletNotFound           Code = "WALLET_NOT_FOUND"
+	CodeWalletServiceUnavailable Code = "WALLET_SERVICE_UNAVAILABLE"
+	CodeWalletDeleted            Code = "WALLET_DELETED"
+	CodeNetworkMismatch          Code = "NETWORK_MISMATCH"
+	CodeAssetNotSupported        Code = "ASSET_NETWORK_UNSUPPORTED"
+	CodeScheduleNotFound         Code = "RECURRING_SCHEDULE_NOT_FOUND"
+	CodeScheduleNotActive        Code = "RECURRING_SCHEDULE_NOT_ACTIVE"
+	CodeScheduleNotPaused        Code = "RECURRING_SCHEDULE_NOT_PAUSED"
+	CodeScheduleFinished         Code = "RECURRING_SCHEDULE_FINISHED"
+	CodeScheduleExhausted        Code = "RECURRING_SCHEDULE_EXHAUSTED"
+	CodeSubscriptionNotFound     Code = "WEBHOOK_SUBSCRIPTION_NOT_FOUND"
+	CodeDeliveryNotFound         Code = "WEBHOOK_DELIVERY_NOT_FOUND"
+	CodeDeliveryInProgress       Code = "WEBHOOK_DELIVERY_IN_PROGRESS"
+)
+
+// Details carries structured, code specific information about an error, e.g. the balance that was available
+type Details map[string]any
+
+// Error is a domain error with a stable code and the HTTP status it is reported with.
+// Errors are compared by code, so a copy carrying details still matches the sentinel it was derived from.
+type Error struct {
+	Code    Code
+	Status  int
+	Message string
+	Details Details
+}
+
+// New creates an error with the given code, HTTP status and human-readable message
+func New(code Code, status int, message string) *Error {
+	return &Error{Code: code, Status: status, Message: message}
+}
+
+// Error returns the human-readable message of the error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether the target is an Error with the same code
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithDetails returns a copy of the error carrying the given details, leaving the receiver untouched
+func (e *Error) WithDetails(details Details) *Error {
+	err := *e
+	err.Details = details
+	return &err
+}
+
+// AddDetails attaches details to the catalogue error wrapped by err while keeping the message of err, e.g. to tell
+// which part of a request failed. Details the catalogue error already carries are kept unless they are overridden.
+// An err that does not wrap a catalogue error is returned unchanged.
+func AddDetails(err error, details Details) error {
+	var appErr *Error
+	if !errors.As(err, &appErr) {
+		return err
+	}
+
+	merged := Details{}
+	for key, value := range appErr.Details {
+		merged[key] = value
+	}
+	for key, value := range details {
+		merged[key] = value
+	}
+
+	return &detailedError{err: err, detailed: appErr.WithDetails(merged)}
+}
+
+// detailedError reports the message of err, while the catalogue error carrying the added details is found first
+// when it is unwrapped
+type detailedError struct {
+	err      error
+	detailed *Error
+}
+
+func (e *detailedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *detailedError) Unwrap() []error {
+	return []error{e.detailed, e.err}
+}
+
+// codeOf maps an HTTP status to the generic code used for errors that were not raised from the catalogue
+func codeOf(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidRequest
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessable
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+
+	return Code(fmt.Sprintf("HTTP_%d", status))
+}
@@ -0,0 +1,121 @@
+package apperror
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	errInsufficientBalance := New(CodeInsufficientBalance, http.StatusConflict, "insufficient balance")
+
+	tests := []struct {
+		name     string
+		err      error
+		target   error
+		expected bool
+	}{
+		{
+			name:     "when the error is the sentinel then should match",
+			err:      errInsufficientBalance,
+			target:   errInsufficientBalance,
+			expected: true,
+		},
+		{
+			name:     "when the error carries details then should still match the sentinel",
+			err:      errInsufficientBalance.WithDetails(Details{"available": "1"}),
+			target:   errInsufficientBalance,
+			expected: true,
+		},
+		{
+			name:     "when the error is wrapped then should match the sentinel",
+			err:      errors.Wrap(errInsufficientBalance, "wallet 1"),
+			target:   errInsufficientBalance,
+			expected: true,
+		},
+		{
+			name:     "when another package defines the same code then should match",
+			err:      New(CodeInsufficientBalance, http.StatusConflict, "amount is not enough"),
+			target:   errInsufficientBalance,
+			expected: true,
+		},
+		{
+			name:     "when the codes differ then should not match",
+			err:      New(CodeInsufficientHold, http.StatusConflict, "insufficient hold"),
+			target:   errInsufficientBalance,
+			expected: false,
+		},
+		{
+			name:     "when the error is not part of the catalogue then should not match",
+			err:      errors.New("insufficient balance"),
+			target:   errInsufficientBalance,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestError_WithDetails(t *testing.T) {
+	sentinel := New(CodeWalletNotFound, http.StatusBadRequest, "wallet not found")
+
+	err := sentinel.WithDetails(Details{"wallet_id": 1})
+
+	assert.Equal(t, Details{"wallet_id": 1}, err.Details)
+	assert.Equal(t, sentinel.Code, err.Code)
+	assert.Equal(t, sentinel.Status, err.Status)
+	assert.Nil(t, sentinel.Details)
+}
+
+func TestAddDetails(t *testing.T) {
+	errInsufficientBalance := New(CodeInsufficientBalance, http.StatusConflict, "insufficient balance")
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedMessage string
+		expectedDetails Details
+	}{
+		{
+			name:            "when the error is a catalogue error then should add the details",
+			err:             errInsufficientBalance,
+			expectedMessage: "insufficient balance",
+			expectedDetails: Details{"leg": 1},
+		},
+		{
+			name: "when the error is wrapped then should keep its message",
+			err: errors.Wrap(errInsufficientBalance.WithDetails(Details{"available": "1", "leg": 0}),
+				"leg 1"),
+			expectedMessage: "leg 1: insufficient balance",
+			expectedDetails: Details{"available": "1", "leg": 1},
+		},
+		{
+			name:            "when the error is not part of the catalogue then should return it unchanged",
+			err:             errors.New("connection reset"),
+			expectedMessage: "connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AddDetails(tt.err, Details{"leg": 1})
+
+			assert.Equal(t, tt.expectedMessage, err.Error())
+			assert.ErrorIs(t, err, tt.err)
+
+			var appErr *Error
+			if tt.expectedDetails == nil {
+				assert.False(t, errors.As(err, &appErr))
+				return
+			}
+			assert.True(t, errors.As(err, &appErr))
+			assert.Equal(t, tt.expectedDetails, appErr.Details)
+			assert.ErrorIs(t, err, errInsufficientBalance)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package apperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/log"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+// ContentType is the media type of RFC 7807 problem details responses
+const ContentType = "application/problem+json"
+
+// Problem is the RFC 7807 problem details body every failed request is answered with.
+// Code and Details are extension members carrying the catalogue code and its structured information.
+type Problem struct {
+	Type     string  `json:"type"`
+	Title    string  `json:"title"`
+	Status   int     `json:"status"`
+	Detail   string  `json:"detail,omitempty"`
+	Instance string  `json:"instance,omitempty"`
+	Code     Code    `json:"code"`
+	Details  Details `json:"details,omitempty"`
+}
+
+// NewProblem describes err as problem details. Catalogue errors keep their code, status and details, errors raised
+// by Echo are given the generic code of their status and anything else is reported as an internal error. The detail
+// of server errors is omitted so internal failures do not leak to clients.
+func NewProblem(err error) *Problem {
+	var problem Problem
+
+	var appErr *Error
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &appErr):
+		problem = Problem{Title: appErr.Message, Status: appErr.Status, Detail: err.Error(), Code: appErr.Code,
+			Details: appErr.Details}
+	case errors.As(err, &httpErr):
+		problem = Problem{Title: http.StatusText(httpErr.Code), Status: httpErr.Code,
+			Detail: fmt.Sprint(httpErr.Message), Code: codeOf(httpErr.Code)}
+	default:
+		problem = Problem{Status: http.StatusInternalServerError, Code: CodeInternal}
+	}
+
+	if problem.Title == "" {
+		problem.Title = http.StatusText(problem.Status)
+	}
+	if problem.Status >= http.StatusInternalServerError {
+		problem.Detail = ""
+	}
+	problem.Type = "urn:problem-type:" + strings.ToLower(strings.ReplaceAll(string(problem.Code), "_", "-"))
+
+	return &problem
+}
+
+// HTTPErrorHandler is an echo.HTTPErrorHandler that logs the error and answers with problem details
+func HTTPErrorHandler(err error, c echo.Context) {
+	problem := NewProblem(err)
+	problem.Instance = c.Request().URL.Path
+
+	fields := []zap.Field{zap.String("method", c.Request().Method), zap.String("path", c.Request().URL.Path),
+		zap.Int("status", problem.Status), zap.String("code", string(problem.Code))}
+	if problem.Status >= http.StatusInternalServerError {
+		log.Logger.Error(err.Error(), fields...)
+	} else {
+		log.Logger.Info(err.Error(), fields...)
+	}
+
+	// The response may already be on its way, e.g. when a handler failed while streaming
+	if c.Response().Committed {
+		return
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		var body []byte
+		body, err = json.Marshal(problem)
+		if err == nil {
+			err = c.Blob(problem.Status, ContentType, body)
+		}
+	}
+	if err != nil {
+		log.Logger.Error("failed to write error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,142 @@
+package apperror
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewProblem(t *testing.T) {
+	errInsufficientBalance := New(CodeInsufficientBalance, http.StatusConflict, "insufficient balance")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *Problem
+	}{
+		{
+			name: "when a catalogue error is given then should keep its code, status and details",
+			err:  errInsufficientBalance.WithDetails(Details{"available": "1"}),
+			expected: &Problem{
+				Type:    "urn:problem-type:insufficient-balance",
+				Title:   "insufficient balance",
+				Status:  http.StatusConflict,
+				Detail:  "insufficient balance",
+				Code:    CodeInsufficientBalance,
+				Details: Details{"available": "1"},
+			},
+		},
+		{
+			name: "when a catalogue error is wrapped then should describe it with the wrapping context",
+			err:  errors.Wrap(errInsufficientBalance, "leg 2"),
+			expected: &Problem{
+				Type:   "urn:problem-type:insufficient-balance",
+				Title:  "insufficient balance",
+				Status: http.StatusConflict,
+				Detail: "leg 2: insufficient balance",
+				Code:   CodeInsufficientBalance,
+			},
+		},
+		{
+			name: "when an echo error is given then should use the generic code of its status",
+			err:  echo.NewHTTPError(http.StatusBadRequest, "amount: must be greater than zero."),
+			expected: &Problem{
+				Type:   "urn:problem-type:invalid-request",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "amount: must be greater than zero.",
+				Code:   CodeInvalidRequest,
+			},
+		},
+		{
+			name: "when an echo server error is given then should not leak its message",
+			err:  echo.NewHTTPError(http.StatusInternalServerError, "pq: connection refused"),
+			expected: &Problem{
+				Type:   "urn:problem-type:internal-error",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Code:   CodeInternal,
+			},
+		},
+		{
+			name: "when an unknown error is given then should report an internal error",
+			err:  errors.New("pq: connection refused"),
+			expected: &Problem{
+				Type:   "urn:problem-type:internal-error",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Code:   CodeInternal,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewProblem(tt.err))
+		})
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		err            error
+		expectedStatus int
+		expectedCode   Code
+		expectedBody   bool
+	}{
+		{
+			name:           "when a catalogue error is handled then should respond with problem details",
+			method:         http.MethodPost,
+			err:            New(CodeWalletNotFound, http.StatusBadRequest, "wallet not found"),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   CodeWalletNotFound,
+			expectedBody:   true,
+		},
+		{
+			name:           "when an error without HTTP status is handled then should respond internal server error",
+			method:         http.MethodGet,
+			err:            errors.New("unexpected"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   CodeInternal,
+			expectedBody:   true,
+		},
+		{
+			name:           "when a HEAD request fails then should respond without a body",
+			method:         http.MethodHead,
+			err:            echo.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/assets", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			HTTPErrorHandler(tt.err, ctx)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if !tt.expectedBody {
+				assert.Empty(t, rec.Body.String())
+				return
+			}
+
+			assert.Equal(t, ContentType, rec.Header().Get(echo.HeaderContentType))
+
+			var problem Problem
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, tt.expectedStatus, problem.Status)
+			assert.Equal(t, tt.expectedCode, problem.Code)
+			assert.Equal(t, "/api/assets", problem.Instance)
+		})
+	}
+}
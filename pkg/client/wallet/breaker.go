@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// breaker is a circuit breaker guarding the wallet service. It opens after a number of consecutive failures and
+// rejects requests while open. Once the open duration has passed, a single trial request is let through: it closes the
+// breaker if it succeeds and opens it again if it fails.
+type breaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func newBreaker(failureThreshold int, openDuration time.Duration) *breaker {
+	return &breaker{failureThreshold: failureThreshold, openDuration: openDuration, now: time.Now}
+}
+
+// allow reports whether a request may be sent to the wallet service
+func (b *breaker) allow() bool {
+	if b.failureThreshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		// Let a single trial request find out whether the wallet service recovered
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// success records that the wallet service answered, closing the breaker
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+}
+
+// failure records that the wallet service could not answer, opening the breaker once too many failures followed each
+// other or when the trial request failed
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.failureThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// release gives up the trial request of a half-open breaker without an outcome, e.g. because its caller went away, so
+// that another request can try
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+		b.openedAt = b.now().Add(-b.openDuration)
+	}
+}
@@ -0,0 +1,238 @@
+package wallet
+
+import (
+	"container/list"
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"golang.org/x/sync/singleflight"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// CacheConfig configures how wallets are cached by a CachedClient
+type CacheConfig struct {
+	// TTL is how long a wallet that was found is served from the cache
+	TTL time.Duration
+	// NegativeTTL is how long a wallet that does not exist keeps being reported as not found
+	NegativeTTL time.Duration
+	// MaxEntries bounds the number of cached wallets; the least recently used ones are evicted first
+	MaxEntries int
+}
+
+// CachedClient is a Client that caches the wallets it fetched, along with the means to drop them once they changed
+type CachedClient interface {
+	Client
+	// Invalidate drops the cached wallet so that the next lookup fetches it from the wallet service again
+	Invalidate(id uint)
+	// InvalidateAll drops every cached wallet
+	InvalidateAll()
+}
+
+type cacheEntry struct {
+	id        uint
+	wallet    *entity.Wallet
+	err       error
+	expiresAt time.Time
+}
+
+type cachedClient struct {
+	client Client
+	config CacheConfig
+	now    func() time.Time
+	group  singleflight.Group
+
+	mu      sync.Mutex
+	entries map[uint]*list.Element
+	// recency orders the entries from the most to the least recently used
+	recency *list.List
+	// generation changes on every invalidation, so that lookups in flight meanwhile do not cache what they fetched
+	generation uint64
+}
+
+// NewCachedClient wraps a client with an in-memory cache. Wallets are cached for the TTL and wallets that do not
+// exist for the negative TTL; other errors are not cached. Concurrent lookups of the same wallet share a single request
+// to the wallet service.
+func NewCachedClient(client Client, config CacheConfig) CachedClient {
+	return &cachedClient{
+		client:  client,
+		config:  config,
+		now:     time.Now,
+		entries: make(map[uint]*list.Element),
+		recency: list.New(),
+	}
+}
+
+// GetWallet returns the cached wallet, fetching it from the wallet service if it is not cached or has expired.
+// A caller whose context ends stops waiting, without cancelling the request shared with other callers.
+func (c *cachedClient) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	if wallet, err, ok := c.get(id); ok {
+		return wallet, err
+	}
+
+	result := c.group.DoChan(strconv.FormatUint(uint64(id), 10), func() (interface{}, error) {
+		return c.fetch(context.WithoutCancel(ctx), id)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		// Hand out a copy, so that callers sharing the lookup cannot change each other's wallet
+		wallet := *res.Val.(*entity.Wallet)
+		return &wallet, nil
+	}
+}
+
+// GetWallets returns the cached wallets and fetches the others from the wallet service in a single lookup. The fetched
+// wallets are cached like those of GetWallet, and so are the wallets that turned out not to exist.
+func (c *cachedClient) GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error) {
+	wallets := make(map[uint]*entity.Wallet, len(ids))
+
+	var missing []uint
+	for _, id := range uniqueIDs(ids) {
+		wallet, err, ok := c.get(id)
+		switch {
+		case !ok:
+			missing = append(missing, id)
+		case err == nil:
+			wallets[id] = wallet
+		}
+	}
+
+	if len(missing) == 0 {
+		return wallets, nil
+	}
+
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	fetched, err := c.client.GetWallets(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cacheable := c.config.MaxEntries > 0 && generation == c.generation
+	for _, id := range missing {
+		wallet, found := fetched[id]
+		switch {
+		case found:
+			// Hand out a copy, so that the caller cannot change the cached wallet
+			copied := *wallet
+			wallets[id] = &copied
+
+			if cacheable && c.config.TTL > 0 {
+				c.put(&cacheEntry{id: id, wallet: wallet, expiresAt: c.now().Add(c.config.TTL)})
+			}
+		case cacheable && c.config.NegativeTTL > 0:
+			c.put(&cacheEntry{id: id, err: ErrWalletNotFound, expiresAt: c.now().Add(c.config.NegativeTTL)})
+		}
+	}
+
+	return wallets, nil
+}
+
+// Invalidate drops the cached wallet, including a lookup of it that is in flight
+func (c *cachedClient) Invalidate(id uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.group.Forget(strconv.FormatUint(uint64(id), 10))
+	if element, ok := c.entries[id]; ok {
+		c.remove(element)
+	}
+}
+
+// InvalidateAll drops every cached wallet
+func (c *cachedClient) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for id := range c.entries {
+		c.group.Forget(strconv.FormatUint(uint64(id), 10))
+	}
+	c.entries = make(map[uint]*list.Element)
+	c.recency.Init()
+}
+
+// get looks the wallet up in the cache, reporting false if it is not cached or has expired
+func (c *cachedClient) get(id uint) (*entity.Wallet, error, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[id]
+	if !ok {
+		return nil, nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, nil, false
+	}
+
+	c.recency.MoveToFront(element)
+	if entry.err != nil {
+		return nil, entry.err, true
+	}
+
+	wallet := *entry.wallet
+	return &wallet, nil, true
+}
+
+// fetch requests the wallet from the wallet service and caches the outcome, unless the cache was invalidated while
+// the request was in flight
+func (c *cachedClient) fetch(ctx context.Context, id uint) (*entity.Wallet, error) {
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	wallet, err := c.client.GetWallet(ctx, id)
+
+	var ttl time.Duration
+	switch {
+	case err == nil:
+		ttl = c.config.TTL
+	case errors.Is(err, ErrWalletNotFound):
+		ttl = c.config.NegativeTTL
+	default:
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ttl > 0 && c.config.MaxEntries > 0 && generation == c.generation {
+		c.put(&cacheEntry{id: id, wallet: wallet, err: err, expiresAt: c.now().Add(ttl)})
+	}
+
+	return wallet, err
+}
+
+// put caches the entry, evicting the least recently used entries beyond the size bound
+func (c *cachedClient) put(entry *cacheEntry) {
+	if element, ok := c.entries[entry.id]; ok {
+		c.remove(element)
+	}
+
+	c.entries[entry.id] = c.recency.PushFront(entry)
+	for c.recency.Len() > c.config.MaxEntries {
+		c.remove(c.recency.Back())
+	}
+}
+
+func (c *cachedClient) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*cacheEntry).id)
+	c.recency.Remove(element)
+}
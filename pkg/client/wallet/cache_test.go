@@ -0,0 +1,289 @@
+package wallet
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubClient answers lookups with getWallet and counts the requests made to it
+type stubClient struct {
+	calls     atomic.Int32
+	getWallet func(ctx context.Context, id uint) (*entity.Wallet, error)
+	// bulkIDs records the IDs of every bulk lookup
+	bulkIDs [][]uint
+}
+
+func (c *stubClient) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	c.calls.Add(1)
+	return c.getWallet(ctx, id)
+}
+
+func (c *stubClient) GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error) {
+	c.calls.Add(1)
+	c.bulkIDs = append(c.bulkIDs, ids)
+
+	wallets := make(map[uint]*entity.Wallet, len(ids))
+	for _, id := range ids {
+		wallet, err := c.getWallet(ctx, id)
+		if errors.Is(err, ErrWalletNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		wallets[id] = wallet
+	}
+
+	return wallets, nil
+}
+
+func newStubClient(errs map[uint]error) *stubClient {
+	return &stubClient{getWallet: func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		if err := errs[id]; err != nil {
+			return nil, err
+		}
+		return &entity.Wallet{ID: id, Network: "bitcoin"}, nil
+	}}
+}
+
+func TestCachedClient_GetWallet(t *testing.T) {
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	config := CacheConfig{TTL: time.Minute, NegativeTTL: 10 * time.Second, MaxEntries: 2}
+
+	type lookup struct {
+		id            uint
+		after         time.Duration
+		expectedError error
+	}
+
+	tests := []struct {
+		name          string
+		errs          map[uint]error
+		lookups       []lookup
+		expectedCalls int32
+	}{
+		{
+			name:          "when a wallet is looked up again within the TTL then should serve it from the cache",
+			lookups:       []lookup{{id: 1}, {id: 1, after: 59 * time.Second}},
+			expectedCalls: 1,
+		},
+		{
+			name:          "when the TTL has passed then should fetch the wallet again",
+			lookups:       []lookup{{id: 1}, {id: 1, after: time.Minute}},
+			expectedCalls: 2,
+		},
+		{
+			name: "when a wallet does not exist then should remember it for the negative TTL",
+			errs: map[uint]error{1: ErrWalletNotFound},
+			lookups: []lookup{
+				{id: 1, expectedError: ErrWalletNotFound},
+				{id: 1, after: 9 * time.Second, expectedError: ErrWalletNotFound},
+				{id: 1, after: 10 * time.Second, expectedError: ErrWalletNotFound},
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "when the wallet service fails then should not cache the failure",
+			errs: map[uint]error{1: errors.New("unexpected status code: 500")},
+			lookups: []lookup{
+				{id: 1, expectedError: errors.New("unexpected status code: 500")},
+				{id: 1, expectedError: errors.New("unexpected status code: 500")},
+			},
+			expectedCalls: 2,
+		},
+		{
+			name:          "when more wallets are cached than allowed then should evict the least recently used one",
+			lookups:       []lookup{{id: 1}, {id: 2}, {id: 1}, {id: 3}, {id: 1}, {id: 2}},
+			expectedCalls: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newStubClient(tt.errs)
+			c := NewCachedClient(stub, config).(*cachedClient)
+			now := start
+			c.now = func() time.Time { return now }
+
+			for _, l := range tt.lookups {
+				now = start.Add(l.after)
+				wallet, err := c.GetWallet(context.Background(), l.id)
+
+				if l.expectedError != nil {
+					assert.EqualError(t, err, l.expectedError.Error())
+					assert.Nil(t, wallet)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, &entity.Wallet{ID: l.id, Network: "bitcoin"}, wallet)
+				}
+			}
+
+			assert.Equal(t, tt.expectedCalls, stub.calls.Load())
+		})
+	}
+}
+
+func TestCachedClient_GetWallets(t *testing.T) {
+	stub := newStubClient(map[uint]error{2: ErrWalletNotFound})
+	c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, NegativeTTL: time.Minute, MaxEntries: 10})
+
+	_, err := c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+
+	// Only the wallets that are not cached yet are fetched, in a single lookup
+	wallets, err := c.GetWallets(context.Background(), []uint{1, 2, 3, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]*entity.Wallet{
+		1: {ID: 1, Network: "bitcoin"},
+		3: {ID: 3, Network: "bitcoin"},
+	}, wallets)
+
+	// Wallets that were found and wallets that do not exist are both served from the cache afterwards
+	wallets, err = c.GetWallets(context.Background(), []uint{2, 3, 4})
+	assert.NoError(t, err)
+	assert.Len(t, wallets, 2)
+
+	assert.Equal(t, [][]uint{{2, 3}, {4}}, stub.bulkIDs)
+	assert.Equal(t, int32(3), stub.calls.Load())
+}
+
+func TestCachedClient_GetWalletReturnsCopies(t *testing.T) {
+	stub := newStubClient(nil)
+	c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, MaxEntries: 10})
+
+	wallet, err := c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+	wallet.Network = "changed"
+
+	wallet, err = c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "bitcoin", wallet.Network)
+}
+
+func TestCachedClient_SingleFlight(t *testing.T) {
+	release := make(chan struct{})
+	stub := &stubClient{getWallet: func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		<-release
+		return &entity.Wallet{ID: id}, nil
+	}}
+	c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, MaxEntries: 10})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wallet, err := c.GetWallet(context.Background(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, uint(1), wallet.ID)
+		}()
+	}
+
+	// Give every lookup the chance to join the one in flight before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), stub.calls.Load())
+}
+
+func TestCachedClient_GetWalletContextDone(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	stub := &stubClient{getWallet: func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		<-release
+		return &entity.Wallet{ID: id}, nil
+	}}
+	c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, MaxEntries: 10})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	wallet, err := c.GetWallet(ctx, 1)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, wallet)
+}
+
+func TestCachedClient_Invalidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		invalidate    func(c CachedClient)
+		expectedCalls int32
+	}{
+		{
+			name:          "when the wallet is invalidated then should fetch it again",
+			invalidate:    func(c CachedClient) { c.Invalidate(1) },
+			expectedCalls: 3,
+		},
+		{
+			name:          "when another wallet is invalidated then should keep serving the cached one",
+			invalidate:    func(c CachedClient) { c.Invalidate(2) },
+			expectedCalls: 2,
+		},
+		{
+			name:          "when every wallet is invalidated then should fetch them again",
+			invalidate:    func(c CachedClient) { c.InvalidateAll() },
+			expectedCalls: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newStubClient(nil)
+			c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, MaxEntries: 10})
+
+			for _, id := range []uint{1, 3} {
+				_, err := c.GetWallet(context.Background(), id)
+				assert.NoError(t, err)
+			}
+
+			tt.invalidate(c)
+
+			for _, id := range []uint{1, 3} {
+				_, err := c.GetWallet(context.Background(), id)
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedCalls, stub.calls.Load())
+		})
+	}
+}
+
+func TestCachedClient_InvalidateDuringLookup(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	stub := &stubClient{getWallet: func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		if id == 1 {
+			close(started)
+			<-release
+		}
+		return &entity.Wallet{ID: id}, nil
+	}}
+	c := NewCachedClient(stub, CacheConfig{TTL: time.Minute, MaxEntries: 10})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := c.GetWallet(context.Background(), 1)
+		assert.NoError(t, err)
+	}()
+
+	// The wallet changes while it is being fetched, so the fetched wallet may already be stale
+	<-started
+	c.Invalidate(1)
+	stub.getWallet = func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		return &entity.Wallet{ID: id}, nil
+	}
+	close(release)
+	<-done
+
+	_, err := c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), stub.calls.Load())
+}
@@ -1,7 +1,17 @@
 package wallet
 
-import "github.com/pkg/errors"
+import (
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"net/http"
+)
 
 var (
-	ErrWalletNotFound = errors.New("wallet not found")
+	ErrWalletNotFound           = apperror.New(apperror.CodeWalletNotFound, http.StatusBadRequest, "wallet not found")
+	ErrWalletServiceUnavailable = apperror.New(apperror.CodeWalletServiceUnavailable, http.StatusServiceUnavailable,
+		"wallet service is unavailable")
+	ErrWalletDeleted   = apperror.New(apperror.CodeWalletDeleted, http.StatusConflict, "wallet is deleted")
+	ErrNetworkMismatch = apperror.New(apperror.CodeNetworkMismatch, http.StatusBadRequest,
+		"source and destination wallets are on different networks")
+	ErrAssetNotSupported = apperror.New(apperror.CodeAssetNotSupported, http.StatusBadRequest,
+		"asset is not supported on the network of the wallet")
 )
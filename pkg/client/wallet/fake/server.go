@@ -0,0 +1,316 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"gopkg.in/guregu/null.v3"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Faults describes the misbehaviour the fake wallet service simulates
+type Faults struct {
+	// Latency delays every response of the wallet endpoints
+	Latency time.Duration
+	// BurstLength requests out of every BurstEvery requests to the wallet endpoints fail with BurstStatus, the burst
+	// coming at the end of each period
+	BurstEvery  int
+	BurstLength int
+	BurstStatus int
+	// DisableBulk answers the bulk endpoint with 404 Not Found, as wallet services predating it do
+	DisableBulk bool
+}
+
+// Server is an in-memory stand-in for the wallet service, serving the endpoints the wallet client uses from a seeded
+// set of wallets. It is meant for running the service locally and for tests, either in-process through httptest or
+// as the fakewallet binary.
+type Server struct {
+	mu       sync.Mutex
+	wallets  map[uint]entity.Wallet
+	faults   Faults
+	requests int
+	// burstRequests counts the requests since the faults were last set, placing them within the burst periods
+	burstRequests int
+	// failNext is the number of upcoming requests failing with failStatus regardless of the faults
+	failNext   int
+	failStatus int
+}
+
+// NewServer creates a fake wallet service serving the given wallets and simulating the given faults
+func NewServer(wallets []entity.Wallet, faults Faults) *Server {
+	s := &Server{wallets: map[uint]entity.Wallet{}, faults: faults}
+	s.Seed(wallets...)
+
+	return s
+}
+
+// LoadFixture reads the wallets to seed a fake wallet service with from a JSON file holding an array of wallets
+func LoadFixture(path string) ([]entity.Wallet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wallet fixture: %w", err)
+	}
+
+	var wallets []entity.Wallet
+	if err = json.Unmarshal(data, &wallets); err != nil {
+		return nil, fmt.Errorf("failed to decode wallet fixture %s: %w", path, err)
+	}
+
+	return wallets, nil
+}
+
+// Seed adds the wallets, replacing those with the same ID
+func (s *Server) Seed(wallets ...entity.Wallet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, wallet := range wallets {
+		s.wallets[wallet.ID] = wallet
+	}
+}
+
+// Delete marks the wallet as deleted, so that it is still served but carries a deletion time. It returns false if the
+// wallet does not exist.
+func (s *Server) Delete(id uint) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wallet, ok := s.wallets[id]
+	if !ok {
+		return false
+	}
+
+	if !wallet.DeletedAt.Valid {
+		wallet.DeletedAt = null.TimeFrom(time.Now().UTC())
+		s.wallets[id] = wallet
+	}
+
+	return true
+}
+
+// Remove drops the wallet, so that lookups of it answer 404 Not Found
+func (s *Server) Remove(id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.wallets, id)
+}
+
+// SetFaults replaces the simulated faults
+func (s *Server) SetFaults(faults Faults) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = faults
+	s.burstRequests = 0
+}
+
+// FailNext makes the next n requests to the wallet endpoints fail with the given status code
+func (s *Server) FailNext(n int, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failNext = n
+	s.failStatus = status
+}
+
+// Requests returns the number of requests the wallet endpoints received
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// Handler returns the fake wallet service as an http.Handler serving the endpoints at the root, as used with
+// httptest.NewServer
+func (s *Server) Handler() http.Handler {
+	e := echo.New()
+	e.HideBanner = true
+	s.RegisterRoutes(e.Group(""))
+
+	return e
+}
+
+// RegisterRoutes registers the wallet endpoints, and the endpoints controlling the fake, with the provided Echo router
+// group
+func (s *Server) RegisterRoutes(e *echo.Group) {
+	e.GET("/wallets", s.GetWallets, s.simulateFaults)
+	e.GET("/wallets/:id", s.GetWallet, s.simulateFaults)
+
+	e.PUT("/fake/wallets", s.SeedWallets)
+	e.DELETE("/fake/wallets/:id", s.DeleteWallet)
+	e.PUT("/fake/faults", s.UpdateFaults)
+}
+
+// GetWallet handles requests to look a single wallet up
+func (s *Server) GetWallet(ctx echo.Context) error {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	s.mu.Lock()
+	wallet, ok := s.wallets[id]
+	s.mu.Unlock()
+
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "wallet not found")
+	}
+
+	return ctx.JSON(http.StatusOK, wallet)
+}
+
+// GetWallets handles requests to look several wallets up at once through GET /wallets?ids=1,2,3. Wallets that do not
+// exist are left out of the response.
+func (s *Server) GetWallets(ctx echo.Context) error {
+	s.mu.Lock()
+	disabled := s.faults.DisableBulk
+	s.mu.Unlock()
+
+	if disabled {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	}
+
+	var ids []uint
+	for _, value := range strings.Split(ctx.QueryParam("ids"), ",") {
+		if value == "" {
+			continue
+		}
+
+		id, err := parseID(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	s.mu.Lock()
+	wallets := make([]entity.Wallet, 0, len(ids))
+	for _, id := range ids {
+		if wallet, ok := s.wallets[id]; ok {
+			wallets = append(wallets, wallet)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(wallets, func(i, j int) bool { return wallets[i].ID < wallets[j].ID })
+
+	return ctx.JSON(http.StatusOK, wallets)
+}
+
+// SeedWallets handles requests adding wallets to the fake, replacing those with the same ID
+func (s *Server) SeedWallets(ctx echo.Context) error {
+	var wallets []entity.Wallet
+	if err := ctx.Bind(&wallets); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	s.Seed(wallets...)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// DeleteWallet handles requests marking a wallet as deleted
+func (s *Server) DeleteWallet(ctx echo.Context) error {
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if !s.Delete(id) {
+		return echo.NewHTTPError(http.StatusNotFound, "wallet not found")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// faultsRequest is the body of requests replacing the simulated faults
+type faultsRequest struct {
+	LatencyMS   int  `json:"latency_ms"`
+	BurstEvery  int  `json:"burst_every"`
+	BurstLength int  `json:"burst_length"`
+	BurstStatus int  `json:"burst_status"`
+	DisableBulk bool `json:"disable_bulk"`
+	// FailNext makes the next requests fail with BurstStatus on top of the bursts
+	FailNext int `json:"fail_next"`
+}
+
+// UpdateFaults handles requests replacing the simulated faults
+func (s *Server) UpdateFaults(ctx echo.Context) error {
+	var request faultsRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	s.SetFaults(Faults{
+		Latency:     time.Duration(request.LatencyMS) * time.Millisecond,
+		BurstEvery:  request.BurstEvery,
+		BurstLength: request.BurstLength,
+		BurstStatus: request.BurstStatus,
+		DisableBulk: request.DisableBulk,
+	})
+	s.FailNext(request.FailNext, request.BurstStatus)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// simulateFaults delays the requests to the wallet endpoints and fails those falling into a burst
+func (s *Server) simulateFaults(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		s.mu.Lock()
+		faults := s.faults
+		n := s.burstRequests
+		s.requests++
+		s.burstRequests++
+
+		status := 0
+		if s.failNext > 0 {
+			s.failNext--
+			status = s.failStatus
+		} else if faults.BurstEvery > 0 && n%faults.BurstEvery >= faults.BurstEvery-faults.BurstLength {
+			status = faults.BurstStatus
+		}
+		s.mu.Unlock()
+
+		if faults.Latency > 0 {
+			select {
+			case <-time.After(faults.Latency):
+			case <-ctx.Request().Context().Done():
+				return ctx.Request().Context().Err()
+			}
+		}
+
+		if status != 0 {
+			return echo.NewHTTPError(failureStatus(status), "simulated failure")
+		}
+
+		return next(ctx)
+	}
+}
+
+// failureStatus defaults the status code of simulated failures to 503 Service Unavailable
+func failureStatus(status int) int {
+	if status < http.StatusBadRequest {
+		return http.StatusServiceUnavailable
+	}
+
+	return status
+}
+
+// parseID parses a wallet ID given in a path or query parameter
+func parseID(value string) (uint, error) {
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid wallet ID %q", value)
+	}
+
+	return uint(id), nil
+}
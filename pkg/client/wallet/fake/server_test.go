@@ -0,0 +1,140 @@
+package fake
+
+import (
+	"context"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServer_Endpoints(t *testing.T) {
+	wallets := []entity.Wallet{{ID: 1, Network: "bitcoin"}, {ID: 2, Network: "ethereum"}}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		faults         Faults
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "when the wallet exists then should return it",
+			method:         http.MethodGet,
+			path:           "/wallets/1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"network":"bitcoin"`,
+		},
+		{
+			name:           "when the wallet does not exist then should respond not found",
+			method:         http.MethodGet,
+			path:           "/wallets/9",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when several wallets are looked up then should return those that exist",
+			method:         http.MethodGet,
+			path:           "/wallets?ids=2,9,1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[{"id":1,`,
+		},
+		{
+			name:           "when the bulk endpoint is disabled then should respond not found",
+			method:         http.MethodGet,
+			path:           "/wallets?ids=1,2",
+			faults:         Faults{DisableBulk: true},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when the request falls into a failure burst then should fail with the burst status",
+			method:         http.MethodGet,
+			path:           "/wallets/1",
+			faults:         Faults{BurstEvery: 1, BurstLength: 1, BurstStatus: http.StatusBadGateway},
+			expectedStatus: http.StatusBadGateway,
+		},
+		{
+			name:           "when wallets are seeded then should add them",
+			method:         http.MethodPut,
+			path:           "/fake/wallets",
+			body:           `[{"id":3,"network":"bitcoin"}]`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "when a wallet is deleted then should mark it as deleted",
+			method:         http.MethodDelete,
+			path:           "/fake/wallets/2",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "when an unknown wallet is deleted then should respond not found",
+			method:         http.MethodDelete,
+			path:           "/fake/wallets/9",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "when the faults are replaced then should accept them",
+			method:         http.MethodPut,
+			path:           "/fake/faults",
+			body:           `{"latency_ms":10,"fail_next":2,"burst_status":503}`,
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(wallets, tt.faults)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			server.Handler().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestServer_WithWalletClient(t *testing.T) {
+	wallets, err := LoadFixture("../../../../cmd/fakewallet/wallets.json")
+	require.NoError(t, err)
+
+	server := NewServer(wallets, Faults{DisableBulk: true})
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	client := wallet.NewResilientClient(wallet.NewClient(httpServer.URL, time.Second, 2), wallet.ResilienceConfig{
+		MaxRetries:     2,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  10 * time.Millisecond,
+	})
+	ctx := context.Background()
+
+	// Failures within the retries of the client go unnoticed
+	server.FailNext(2, http.StatusServiceUnavailable)
+	found, err := client.GetWallet(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "bitcoin", found.Network)
+	assert.Equal(t, 3, server.Requests())
+
+	// Without the bulk endpoint the client falls back to single lookups
+	walletsByID, err := client.GetWallets(ctx, []uint{3, 4, 9})
+	assert.NoError(t, err)
+	assert.Len(t, walletsByID, 2)
+
+	// Deleted wallets are still served, carrying their deletion time
+	server.Delete(2)
+	found, err = client.GetWallet(ctx, 2)
+	assert.NoError(t, err)
+	assert.True(t, found.DeletedAt.Valid)
+
+	_, err = client.GetWallet(ctx, 9)
+	assert.ErrorIs(t, err, wallet.ErrWalletNotFound)
+}
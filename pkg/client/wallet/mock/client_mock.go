@@ -81,6 +81,65 @@ func (_c *MockWalletClient_GetWallet_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetWallets provides a mock function with given fields: ctx, ids
+func (_m *MockWalletClient) GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWallets")
+	}
+
+	var r0 map[uint]*entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) (map[uint]*entity.Wallet, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) map[uint]*entity.Wallet); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletClient_GetWallets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWallets'
+type MockWalletClient_GetWallets_Call struct {
+	*mock.Call
+}
+
+// GetWallets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+func (_e *MockWalletClient_Expecter) GetWallets(ctx interface{}, ids interface{}) *MockWalletClient_GetWallets_Call {
+	return &MockWalletClient_GetWallets_Call{Call: _e.mock.On("GetWallets", ctx, ids)}
+}
+
+func (_c *MockWalletClient_GetWallets_Call) Run(run func(ctx context.Context, ids []uint)) *MockWalletClient_GetWallets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletClient_GetWallets_Call) Return(_a0 map[uint]*entity.Wallet, _a1 error) *MockWalletClient_GetWallets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletClient_GetWallets_Call) RunAndReturn(run func(context.Context, []uint) (map[uint]*entity.Wallet, error)) *MockWalletClient_GetWallets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWalletClient creates a new instance of MockWalletClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWalletClient(t interface {
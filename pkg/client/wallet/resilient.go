@@ -0,0 +1,155 @@
+package wallet
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// ResilienceConfig configures how a resilient client retries lookups and when it stops calling the wallet service
+type ResilienceConfig struct {
+	// MaxRetries is the number of times a failed lookup is retried, on top of the first attempt
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry; it doubles with every further retry
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the delay between two attempts; a Retry-After asking for longer ends the retries
+	RetryMaxDelay time.Duration
+	// RetryJitter is the fraction (0 to 1) by which a delay is randomly shortened or lengthened
+	RetryJitter float64
+	// FailureThreshold is the number of consecutive failed attempts that opens the circuit breaker; zero disables it
+	FailureThreshold int
+	// OpenDuration is how long the open circuit breaker fails lookups fast before letting a trial lookup through
+	OpenDuration time.Duration
+}
+
+type resilientClient struct {
+	client  Client
+	config  ResilienceConfig
+	breaker *breaker
+	random  func() float64
+}
+
+// NewResilientClient wraps a client with retries and a circuit breaker. Lookups failing with a transient error, i.e.
+// a network error, a timeout or a 5xx, 408 or 429 response, are retried with exponential backoff and jitter, honouring
+// the Retry-After of the response. Once the wallet service keeps failing, the circuit breaker opens and lookups fail
+// fast with ErrWalletServiceUnavailable until the service recovers.
+func NewResilientClient(client Client, config ResilienceConfig) Client {
+	return &resilientClient{
+		client:  client,
+		config:  config,
+		breaker: newBreaker(config.FailureThreshold, config.OpenDuration),
+		random:  rand.Float64,
+	}
+}
+
+// GetWallet looks the wallet up, retrying transient failures.
+//
+// Errors:
+//   - ErrWalletNotFound: if the wallet does not exist
+//   - ErrWalletServiceUnavailable: if the circuit breaker is open or the lookup failed on every attempt
+//   - the context error: if the context ended before the lookup completed
+func (c *resilientClient) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	var wallet *entity.Wallet
+	err := c.call(ctx, func() error {
+		var err error
+		wallet, err = c.client.GetWallet(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+// GetWallets looks the wallets up at once, retrying transient failures of the whole lookup. It fails like GetWallet,
+// except that wallets that do not exist are missing from the result.
+func (c *resilientClient) GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error) {
+	var wallets map[uint]*entity.Wallet
+	err := c.call(ctx, func() error {
+		var err error
+		wallets, err = c.client.GetWallets(ctx, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return wallets, nil
+}
+
+// call runs a lookup guarded by the circuit breaker, retrying it while it fails transiently
+func (c *resilientClient) call(ctx context.Context, lookup func() error) error {
+	for attempt := 0; ; attempt++ {
+		if !c.breaker.allow() {
+			return errors.Wrap(ErrWalletServiceUnavailable, "circuit breaker is open")
+		}
+
+		err := lookup()
+		if err == nil || !isTransient(err) {
+			c.breaker.success()
+			return err
+		}
+
+		if ctx.Err() != nil {
+			// The lookup failed because its caller went away, which says nothing about the wallet service
+			c.breaker.release()
+			return ctx.Err()
+		}
+
+		c.breaker.failure()
+
+		delay, retry := c.nextDelay(attempt, err)
+		if !retry {
+			return errors.Wrapf(ErrWalletServiceUnavailable, "lookup failed after %d attempts: %s", attempt+1, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// nextDelay returns the delay before retrying a lookup that failed with err on the given attempt, reporting false if
+// the lookup is not retried anymore
+func (c *resilientClient) nextDelay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= c.config.MaxRetries {
+		return 0, false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		if c.config.RetryMaxDelay > 0 && statusErr.RetryAfter > c.config.RetryMaxDelay {
+			return 0, false
+		}
+		return statusErr.RetryAfter, true
+	}
+
+	delay := float64(c.config.RetryBaseDelay) * math.Pow(2, float64(attempt))
+	if c.config.RetryMaxDelay > 0 && delay > float64(c.config.RetryMaxDelay) {
+		delay = float64(c.config.RetryMaxDelay)
+	}
+
+	if c.config.RetryJitter > 0 && c.random != nil {
+		delay += delay * c.config.RetryJitter * (2*c.random() - 1)
+	}
+
+	return time.Duration(delay), true
+}
+
+// isTransient reports whether a lookup failing with err may succeed when attempted again
+func isTransient(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError ||
+			statusErr.StatusCode == http.StatusRequestTimeout || statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	return !errors.Is(err, ErrWalletNotFound)
+}
@@ -0,0 +1,224 @@
+package wallet
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// sequenceClient answers the n-th lookup with the n-th error, succeeding once the errors run out
+func sequenceClient(errs ...error) *stubClient {
+	stub := &stubClient{}
+	stub.getWallet = func(ctx context.Context, id uint) (*entity.Wallet, error) {
+		if n := int(stub.calls.Load()) - 1; n < len(errs) && errs[n] != nil {
+			return nil, errs[n]
+		}
+		return &entity.Wallet{ID: id, Network: "bitcoin"}, nil
+	}
+	return stub
+}
+
+func TestResilientClient_GetWallet(t *testing.T) {
+	config := ResilienceConfig{MaxRetries: 2, RetryBaseDelay: time.Millisecond, RetryMaxDelay: 10 * time.Millisecond}
+	unavailable := &StatusError{StatusCode: http.StatusServiceUnavailable}
+
+	tests := []struct {
+		name          string
+		errs          []error
+		expectedError error
+		expectedCalls int32
+	}{
+		{
+			name:          "when the first attempt succeeds then should not retry",
+			expectedCalls: 1,
+		},
+		{
+			name:          "when the wallet service fails transiently then should retry until it answers",
+			errs:          []error{unavailable, errors.New("failed to send request: connection refused")},
+			expectedCalls: 3,
+		},
+		{
+			name:          "when every attempt fails then should return ErrWalletServiceUnavailable",
+			errs:          []error{unavailable, unavailable, unavailable},
+			expectedError: ErrWalletServiceUnavailable,
+			expectedCalls: 3,
+		},
+		{
+			name:          "when the wallet does not exist then should not retry",
+			errs:          []error{ErrWalletNotFound},
+			expectedError: ErrWalletNotFound,
+			expectedCalls: 1,
+		},
+		{
+			name:          "when the wallet service rejects the request then should not retry",
+			errs:          []error{&StatusError{StatusCode: http.StatusBadRequest}},
+			expectedError: &StatusError{StatusCode: http.StatusBadRequest},
+			expectedCalls: 1,
+		},
+		{
+			name:          "when the wallet service asks to retry later than the maximum delay then should give up",
+			errs:          []error{&StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute}},
+			expectedError: ErrWalletServiceUnavailable,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := sequenceClient(tt.errs...)
+			c := NewResilientClient(stub, config)
+
+			wallet, err := c.GetWallet(context.Background(), 1)
+
+			if tt.expectedError != nil {
+				var statusErr *StatusError
+				if errors.As(tt.expectedError, &statusErr) {
+					assert.Equal(t, tt.expectedError, err)
+				} else {
+					assert.ErrorIs(t, err, tt.expectedError)
+				}
+				assert.Nil(t, wallet)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &entity.Wallet{ID: 1, Network: "bitcoin"}, wallet)
+			}
+
+			assert.Equal(t, tt.expectedCalls, stub.calls.Load())
+		})
+	}
+}
+
+func TestResilientClient_NextDelay(t *testing.T) {
+	c := NewResilientClient(nil, ResilienceConfig{
+		MaxRetries:     5,
+		RetryBaseDelay: 100 * time.Millisecond,
+		RetryMaxDelay:  time.Second,
+		RetryJitter:    0.5,
+	}).(*resilientClient)
+
+	tests := []struct {
+		name          string
+		attempt       int
+		random        float64
+		err           error
+		expectedDelay time.Duration
+		expectedRetry bool
+	}{
+		{
+			name:          "when the first attempt failed then should wait the base delay",
+			random:        0.5,
+			err:           errors.New("timeout"),
+			expectedDelay: 100 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:          "when further attempts failed then should double the delay",
+			attempt:       2,
+			random:        0.5,
+			err:           errors.New("timeout"),
+			expectedDelay: 400 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:          "when the doubled delay exceeds the maximum then should cap it",
+			attempt:       4,
+			random:        0.5,
+			err:           errors.New("timeout"),
+			expectedDelay: time.Second,
+			expectedRetry: true,
+		},
+		{
+			name:          "when jitter applies then should shorten or lengthen the delay",
+			attempt:       1,
+			random:        1,
+			err:           errors.New("timeout"),
+			expectedDelay: 300 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:          "when the wallet service asked to retry after a delay then should wait exactly that long",
+			random:        1,
+			err:           &StatusError{StatusCode: http.StatusServiceUnavailable, RetryAfter: 700 * time.Millisecond},
+			expectedDelay: 700 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:    "when the retries are used up then should not retry",
+			attempt: 5,
+			err:     errors.New("timeout"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.random = func() float64 { return tt.random }
+
+			delay, retry := c.nextDelay(tt.attempt, tt.err)
+
+			assert.Equal(t, tt.expectedRetry, retry)
+			assert.Equal(t, tt.expectedDelay, delay)
+		})
+	}
+}
+
+func TestResilientClient_CircuitBreaker(t *testing.T) {
+	stub := sequenceClient(errors.New("connection refused"), errors.New("connection refused"))
+	c := NewResilientClient(stub, ResilienceConfig{FailureThreshold: 2, OpenDuration: time.Minute}).(*resilientClient)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	c.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		_, err := c.GetWallet(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrWalletServiceUnavailable)
+	}
+
+	// The breaker is open, so the lookup fails fast without reaching the wallet service
+	_, err := c.GetWallet(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrWalletServiceUnavailable)
+	assert.Equal(t, int32(2), stub.calls.Load())
+
+	// Once the open duration has passed, a trial lookup closes the breaker again
+	now = now.Add(time.Minute)
+	wallet, err := c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), wallet.ID)
+
+	wallet, err = c.GetWallet(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), wallet.ID)
+	assert.Equal(t, int32(4), stub.calls.Load())
+}
+
+func TestResilientClient_GetWalletHonoursRetryAfter(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":1,"network":"bitcoin"}`))
+	}))
+	defer server.Close()
+
+	c := NewResilientClient(NewClient(server.URL, time.Second, 1), ResilienceConfig{
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  2 * time.Second,
+	})
+
+	start := time.Now()
+	wallet, err := c.GetWallet(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), wallet.ID)
+	assert.Equal(t, 2, calls)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
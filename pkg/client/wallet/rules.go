@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"fmt"
+	"github.com/safayildirim/asset-management-service/pkg/apperror"
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"strings"
+)
+
+// Rules decides from the metadata of the wallet service which wallets may take part in balance changes and transfers
+type Rules struct {
+	// assetNetworks maps an asset symbol to the set of networks it is supported on
+	assetNetworks map[string]map[string]bool
+}
+
+// NewRules creates rules supporting every asset of assetNetworks only on the listed networks. Assets missing from the
+// map are supported on every network.
+func NewRules(assetNetworks map[string][]string) *Rules {
+	rules := &Rules{assetNetworks: make(map[string]map[string]bool, len(assetNetworks))}
+	for symbol, networks := range assetNetworks {
+		set := make(map[string]bool, len(networks))
+		for _, network := range networks {
+			set[normalizeNetwork(network)] = true
+		}
+		rules.assetNetworks[strings.ToUpper(strings.TrimSpace(symbol))] = set
+	}
+
+	return rules
+}
+
+// ParseAssetNetworks parses an asset to network compatibility map written as "BTC:bitcoin;ETH:ethereum,polygon"
+func ParseAssetNetworks(value string) (map[string][]string, error) {
+	assetNetworks := map[string][]string{}
+	for _, entry := range strings.Split(value, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		symbol, networks, ok := strings.Cut(entry, ":")
+		symbol = strings.TrimSpace(symbol)
+		if !ok || symbol == "" || strings.TrimSpace(networks) == "" {
+			return nil, fmt.Errorf("invalid asset networks entry %q, expected SYMBOL:network[,network]", entry)
+		}
+
+		for _, network := range strings.Split(networks, ",") {
+			if network = strings.TrimSpace(network); network != "" {
+				assetNetworks[symbol] = append(assetNetworks[symbol], network)
+			}
+		}
+	}
+
+	return assetNetworks, nil
+}
+
+// CheckActive returns ErrWalletDeleted if the wallet was deleted in the wallet service
+func (r *Rules) CheckActive(wallet *entity.Wallet) error {
+	if wallet.DeletedAt.Valid {
+		return ErrWalletDeleted.WithDetails(apperror.Details{"wallet_id": wallet.ID})
+	}
+
+	return nil
+}
+
+// CheckAsset returns ErrAssetNotSupported if the asset with the given canonical symbol is not supported on the network
+// of the wallet
+func (r *Rules) CheckAsset(wallet *entity.Wallet, symbol string) error {
+	networks, ok := r.assetNetworks[symbol]
+	if !ok || networks[normalizeNetwork(wallet.Network)] {
+		return nil
+	}
+
+	return ErrAssetNotSupported.WithDetails(apperror.Details{
+		"wallet_id": wallet.ID,
+		"network":   wallet.Network,
+		"asset":     symbol,
+	})
+}
+
+// CheckTransfer checks that an asset with the given canonical symbol may be transferred from source to destination:
+// neither wallet may be deleted, both must be on the same network and the asset must be supported on it.
+//
+// Errors:
+//   - ErrWalletDeleted: if either wallet was deleted
+//   - ErrNetworkMismatch: if the wallets are on different networks
+//   - ErrAssetNotSupported: if the asset is not supported on the network of the wallets
+func (r *Rules) CheckTransfer(source, destination *entity.Wallet, symbol string) error {
+	for _, w := range []*entity.Wallet{source, destination} {
+		if err := r.CheckActive(w); err != nil {
+			return err
+		}
+	}
+
+	if normalizeNetwork(source.Network) != normalizeNetwork(destination.Network) {
+		return ErrNetworkMismatch.WithDetails(apperror.Details{
+			"source_wallet_id":      source.ID,
+			"source_network":        source.Network,
+			"destination_wallet_id": destination.ID,
+			"destination_network":   destination.Network,
+		})
+	}
+
+	return r.CheckAsset(source, symbol)
+}
+
+// normalizeNetwork makes network names compare case-insensitively
+func normalizeNetwork(network string) string {
+	return strings.ToLower(strings.TrimSpace(network))
+}
@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+	"time"
+)
+
+func TestRules_CheckTransfer(t *testing.T) {
+	rules := NewRules(map[string][]string{"BTC": {"Bitcoin"}, "USDT": {"ethereum", "tron"}})
+
+	tests := []struct {
+		name          string
+		source        *entity.Wallet
+		destination   *entity.Wallet
+		symbol        string
+		expectedError error
+	}{
+		{
+			name:        "when both wallets are on a network supporting the asset then should allow the transfer",
+			source:      &entity.Wallet{ID: 1, Network: "bitcoin"},
+			destination: &entity.Wallet{ID: 2, Network: "BITCOIN"},
+			symbol:      "BTC",
+		},
+		{
+			name:        "when the asset has no network restriction then should allow it on any network",
+			source:      &entity.Wallet{ID: 1, Network: "solana"},
+			destination: &entity.Wallet{ID: 2, Network: "solana"},
+			symbol:      "SOL",
+		},
+		{
+			name:          "when the source wallet is deleted then should return error",
+			source:        &entity.Wallet{ID: 1, Network: "tron", DeletedAt: null.TimeFrom(time.Now())},
+			destination:   &entity.Wallet{ID: 2, Network: "tron"},
+			symbol:        "USDT",
+			expectedError: ErrWalletDeleted,
+		},
+		{
+			name:          "when the wallets are on different networks then should return error",
+			source:        &entity.Wallet{ID: 1, Network: "ethereum"},
+			destination:   &entity.Wallet{ID: 2, Network: "tron"},
+			symbol:        "USDT",
+			expectedError: ErrNetworkMismatch,
+		},
+		{
+			name:          "when the asset is not supported on the network then should return error",
+			source:        &entity.Wallet{ID: 1, Network: "ethereum"},
+			destination:   &entity.Wallet{ID: 2, Network: "ethereum"},
+			symbol:        "BTC",
+			expectedError: ErrAssetNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.CheckTransfer(tt.source, tt.destination, tt.symbol)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseAssetNetworks(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      map[string][]string
+		expectedError bool
+	}{
+		{
+			name:     "when value is empty then should return an empty map",
+			value:    "",
+			expected: map[string][]string{},
+		},
+		{
+			name:     "when value lists several assets then should map each to its networks",
+			value:    "BTC:bitcoin; USDT: ethereum, tron;",
+			expected: map[string][]string{"BTC": {"bitcoin"}, "USDT": {"ethereum", "tron"}},
+		},
+		{
+			name:          "when an entry has no networks then should return error",
+			value:         "BTC",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseAssetNetworks(tt.value)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -4,29 +4,59 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/pkg/errors"
 	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
+	"golang.org/x/sync/errgroup"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
+// maxBulkSize bounds the number of wallets requested from the bulk endpoint at once, keeping request URLs short
+const maxBulkSize = 100
+
 type Client interface {
 	GetWallet(ctx context.Context, id uint) (*entity.Wallet, error)
+	// GetWallets looks several wallets up at once, returning them keyed by ID. Wallets that do not exist are missing
+	// from the result rather than reported as an error.
+	GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error)
 }
 
 type client struct {
-	httpClient *http.Client
-	baseURL    string
+	httpClient  *http.Client
+	baseURL     string
+	concurrency int
+	// bulkUnsupported is set once the wallet service turned out not to offer the bulk endpoint
+	bulkUnsupported atomic.Bool
+}
+
+// StatusError reports a response of the wallet service with an unexpected status code
+type StatusError struct {
+	StatusCode int
+	// RetryAfter is the delay the wallet service asked for before trying again, zero if it did not ask for one
+	RetryAfter time.Duration
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d, body: %s", e.StatusCode, e.Body)
 }
 
-func NewClient(baseURL string) Client {
+// NewClient creates a client of the wallet service; timeout bounds each request, including reading its response, and
+// concurrency bounds the requests sent in parallel when wallets are looked up one by one
+func NewClient(baseURL string, timeout time.Duration, concurrency int) Client {
 	return &client{
-		httpClient: &http.Client{Timeout: 10 * time.Second},
-		baseURL:    baseURL,
+		httpClient:  &http.Client{Timeout: timeout},
+		baseURL:     baseURL,
+		concurrency: max(concurrency, 1),
 	}
 }
 
-func (c client) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+func (c *client) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
 	// Construct the request URL
 	url := fmt.Sprintf("%s/wallets/%d", c.baseURL, id)
 
@@ -50,7 +80,8 @@ func (c client) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error)
 	// Check for non-200 status codes
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, body)
+		return nil, &StatusError{StatusCode: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Body: string(body)}
 	}
 
 	// Parse the response body
@@ -61,3 +92,158 @@ func (c client) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error)
 
 	return &wallet, nil
 }
+
+// GetWallets looks the wallets up with the bulk endpoint of the wallet service, GET /wallets?ids=1,2,3. If the wallet
+// service does not offer it, the wallets are looked up one by one with up to the configured number of requests in
+// parallel, and the bulk endpoint is not tried again.
+func (c *client) GetWallets(ctx context.Context, ids []uint) (map[uint]*entity.Wallet, error) {
+	wallets := make(map[uint]*entity.Wallet, len(ids))
+	ids = uniqueIDs(ids)
+
+	for len(ids) > 0 && !c.bulkUnsupported.Load() {
+		chunk := ids[:min(maxBulkSize, len(ids))]
+
+		found, err := c.getWalletsInBulk(ctx, chunk)
+		if errors.Is(err, errBulkUnsupported) {
+			c.bulkUnsupported.Store(true)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, wallet := range found {
+			wallets[wallet.ID] = wallet
+		}
+		ids = ids[len(chunk):]
+	}
+
+	// Look the wallets the bulk endpoint could not be used for up one by one
+	if len(ids) > 0 {
+		err := c.getWalletsConcurrently(ctx, ids, wallets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return wallets, nil
+}
+
+// errBulkUnsupported reports that the wallet service does not offer the bulk endpoint
+var errBulkUnsupported = errors.New("bulk wallet lookup is not supported")
+
+// getWalletsInBulk requests the wallets with the given IDs from the bulk endpoint
+func (c *client) getWalletsInBulk(ctx context.Context, ids []uint) ([]*entity.Wallet, error) {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	query := url.Values{"ids": {strings.Join(values, ",")}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/wallets?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, errBulkUnsupported
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &StatusError{StatusCode: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Body: string(body)}
+	}
+
+	var wallets []*entity.Wallet
+	if err = json.NewDecoder(resp.Body).Decode(&wallets); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return wallets, nil
+}
+
+// getWalletsConcurrently looks the wallets up one by one, with up to the configured number of requests in parallel,
+// adding those that exist to wallets
+func (c *client) getWalletsConcurrently(ctx context.Context, ids []uint, wallets map[uint]*entity.Wallet) error {
+	found := make([]*entity.Wallet, len(ids))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(c.concurrency)
+	for i, id := range ids {
+		group.Go(func() error {
+			wallet, err := c.GetWallet(groupCtx, id)
+			if errors.Is(err, ErrWalletNotFound) {
+				return nil
+			}
+			found[i] = wallet
+			return err
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	for _, wallet := range found {
+		if wallet != nil {
+			wallets[wallet.ID] = wallet
+		}
+	}
+
+	return nil
+}
+
+// uniqueIDs returns the IDs without duplicates, in the order they first appear
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+
+	return 0
+}
+
+// GetAll looks the wallets up with a single GetWallets call, failing with ErrWalletNotFound naming the first wallet
+// that does not exist
+func GetAll(ctx context.Context, client Client, ids ...uint) (map[uint]*entity.Wallet, error) {
+	wallets, err := client.GetWallets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if wallets[id] == nil {
+			return nil, errors.Wrapf(ErrWalletNotFound, "wallet %d", id)
+		}
+	}
+
+	return wallets, nil
+}
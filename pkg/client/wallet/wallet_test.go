@@ -3,11 +3,13 @@ package wallet
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/safayildirim/asset-management-service/pkg/client/wallet/entity"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestGetWallet(t *testing.T) {
@@ -70,7 +72,7 @@ func TestGetWallet(t *testing.T) {
 			defer server.Close()
 
 			// Initialize the client
-			c := NewClient(server.URL)
+			c := NewClient(server.URL, time.Second, 1)
 
 			// Call the GetWallet function
 			result, err := c.GetWallet(context.Background(), tt.walletID)
@@ -86,3 +88,69 @@ func TestGetWallet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWallets(t *testing.T) {
+	existing := map[uint]bool{1: true, 3: true}
+
+	tests := []struct {
+		name             string
+		bulkSupported    bool
+		ids              []uint
+		expectedRequests []string
+	}{
+		{
+			name:             "when the wallet service offers the bulk endpoint then should look the wallets up at once",
+			bulkSupported:    true,
+			ids:              []uint{1, 2, 3, 1},
+			expectedRequests: []string{"/wallets?ids=1,2,3", "/wallets?ids=1,2,3"},
+		},
+		{
+			name: "when the wallet service has no bulk endpoint then should fall back to single lookups",
+			ids:  []uint{1, 2, 3, 1},
+			expectedRequests: []string{
+				"/wallets?ids=1,2,3", "/wallets/1", "/wallets/2", "/wallets/3",
+				"/wallets/1", "/wallets/2", "/wallets/3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/wallets" {
+					requests = append(requests, r.URL.Path+"?ids="+r.URL.Query().Get("ids"))
+					if !tt.bulkSupported {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					json.NewEncoder(w).Encode([]entity.Wallet{{ID: 1}, {ID: 3}})
+					return
+				}
+
+				requests = append(requests, r.URL.Path)
+				var id uint
+				fmt.Sscanf(r.URL.Path, "/wallets/%d", &id)
+				if !existing[id] {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				json.NewEncoder(w).Encode(entity.Wallet{ID: id})
+			}))
+			defer server.Close()
+
+			// A single lookup at a time keeps the order of the requests predictable
+			c := NewClient(server.URL, time.Second, 1)
+
+			// The second lookup shows whether the client remembered that the bulk endpoint is missing
+			for i := 0; i < 2; i++ {
+				wallets, err := c.GetWallets(context.Background(), tt.ids)
+
+				assert.NoError(t, err)
+				assert.Equal(t, map[uint]*entity.Wallet{1: {ID: 1}, 3: {ID: 3}}, wallets)
+			}
+
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
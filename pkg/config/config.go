@@ -10,6 +10,11 @@ type Config struct {
 	Postgres     PostgresConfig
 	WalletClient WalletClientConfig
 	Scheduler    SchedulerConfig
+	Recurring    RecurringConfig
+	Idempotency  IdempotencyConfig
+	Outbox       OutboxConfig
+	Webhook      WebhookConfig
+	Stream       StreamConfig
 }
 
 var BaseConfig *Config
@@ -20,7 +25,48 @@ type AppConfig struct {
 }
 
 type SchedulerConfig struct {
-	Interval int
+	Interval       int
+	LeaseDuration  int
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay int
+	RetryMaxDelay  int
+	RetryJitter    int
+}
+
+type RecurringConfig struct {
+	CatchUpWindow        int
+	MaxOccurrencesPerRun int
+}
+
+type IdempotencyConfig struct {
+	TTL             int
+	LockTimeout     int
+	CleanupInterval int
+}
+
+type OutboxConfig struct {
+	Interval       int
+	LeaseDuration  int
+	BatchSize      int
+	RetryBaseDelay int
+	RetryMaxDelay  int
+}
+
+type WebhookConfig struct {
+	Interval       int
+	LeaseDuration  int
+	BatchSize      int
+	Timeout        int
+	MaxAttempts    int
+	RetryBaseDelay int
+	RetryMaxDelay  int
+}
+
+type StreamConfig struct {
+	PollInterval      int
+	BatchSize         int
+	HeartbeatInterval int
 }
 
 type PostgresConfig struct {
@@ -41,7 +87,19 @@ type HttpConfig struct {
 }
 
 type WalletClientConfig struct {
-	BaseURL string `json:"base_url"`
+	BaseURL             string `json:"base_url"`
+	Timeout             int    `json:"timeout"`
+	Concurrency         int    `json:"concurrency"`
+	MaxRetries          int    `json:"max_retries"`
+	RetryBaseDelay      int    `json:"retry_base_delay"`
+	RetryMaxDelay       int    `json:"retry_max_delay"`
+	RetryJitter         int    `json:"retry_jitter"`
+	BreakerThreshold    int    `json:"breaker_threshold"`
+	BreakerOpenDuration int    `json:"breaker_open_duration"`
+	CacheTTL            int    `json:"cache_ttl"`
+	CacheNegativeTTL    int    `json:"cache_negative_ttl"`
+	CacheMaxEntries     int    `json:"cache_max_entries"`
+	AssetNetworks       string `json:"asset_networks"`
 }
 
 func init() {
@@ -69,8 +127,60 @@ func New() *Config {
 			MaxLifeTimeConn: env.New("PG_MAX_LIFETIME_CONNECTIONS", "20").AsInt(),
 			SslMode:         env.New("PG_SSL_MODE", true).AsString(),
 		},
-		WalletClient: WalletClientConfig{BaseURL: env.New("WALLET_CLIENT_BASE_URL", "").AsString()},
-		Scheduler:    SchedulerConfig{Interval: env.New("SCHEDULER_INTERVAL", 10).AsInt()},
+		WalletClient: WalletClientConfig{
+			BaseURL:             env.New("WALLET_CLIENT_BASE_URL", "").AsString(),
+			Timeout:             env.New("WALLET_CLIENT_TIMEOUT", 5).AsInt(),
+			Concurrency:         env.New("WALLET_CLIENT_CONCURRENCY", 8).AsInt(),
+			MaxRetries:          env.New("WALLET_CLIENT_MAX_RETRIES", 2).AsInt(),
+			RetryBaseDelay:      env.New("WALLET_CLIENT_RETRY_BASE_DELAY", 100).AsInt(),
+			RetryMaxDelay:       env.New("WALLET_CLIENT_RETRY_MAX_DELAY", 2000).AsInt(),
+			RetryJitter:         env.New("WALLET_CLIENT_RETRY_JITTER", 20).AsInt(),
+			BreakerThreshold:    env.New("WALLET_CLIENT_BREAKER_THRESHOLD", 5).AsInt(),
+			BreakerOpenDuration: env.New("WALLET_CLIENT_BREAKER_OPEN_DURATION", 30).AsInt(),
+			CacheTTL:            env.New("WALLET_CACHE_TTL", 60).AsInt(),
+			CacheNegativeTTL:    env.New("WALLET_CACHE_NEGATIVE_TTL", 10).AsInt(),
+			CacheMaxEntries:     env.New("WALLET_CACHE_MAX_ENTRIES", 10000).AsInt(),
+			AssetNetworks:       env.New("WALLET_ASSET_NETWORKS", "").AsString(),
+		},
+		Scheduler: SchedulerConfig{
+			Interval:       env.New("SCHEDULER_INTERVAL", 10).AsInt(),
+			LeaseDuration:  env.New("SCHEDULER_LEASE_DURATION", 60).AsInt(),
+			BatchSize:      env.New("SCHEDULER_BATCH_SIZE", 100).AsInt(),
+			MaxAttempts:    env.New("SCHEDULER_MAX_ATTEMPTS", 5).AsInt(),
+			RetryBaseDelay: env.New("SCHEDULER_RETRY_BASE_DELAY", 10).AsInt(),
+			RetryMaxDelay:  env.New("SCHEDULER_RETRY_MAX_DELAY", 3600).AsInt(),
+			RetryJitter:    env.New("SCHEDULER_RETRY_JITTER", 20).AsInt(),
+		},
+		Recurring: RecurringConfig{
+			CatchUpWindow:        env.New("RECURRING_CATCH_UP_WINDOW", 3600).AsInt(),
+			MaxOccurrencesPerRun: env.New("RECURRING_MAX_OCCURRENCES_PER_RUN", 10).AsInt(),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             env.New("IDEMPOTENCY_KEY_TTL", 86400).AsInt(),
+			LockTimeout:     env.New("IDEMPOTENCY_LOCK_TIMEOUT", 60).AsInt(),
+			CleanupInterval: env.New("IDEMPOTENCY_CLEANUP_INTERVAL", 3600).AsInt(),
+		},
+		Outbox: OutboxConfig{
+			Interval:       env.New("OUTBOX_RELAY_INTERVAL", 5).AsInt(),
+			LeaseDuration:  env.New("OUTBOX_LEASE_DURATION", 60).AsInt(),
+			BatchSize:      env.New("OUTBOX_BATCH_SIZE", 100).AsInt(),
+			RetryBaseDelay: env.New("OUTBOX_RETRY_BASE_DELAY", 5).AsInt(),
+			RetryMaxDelay:  env.New("OUTBOX_RETRY_MAX_DELAY", 600).AsInt(),
+		},
+		Webhook: WebhookConfig{
+			Interval:       env.New("WEBHOOK_DISPATCH_INTERVAL", 5).AsInt(),
+			LeaseDuration:  env.New("WEBHOOK_LEASE_DURATION", 60).AsInt(),
+			BatchSize:      env.New("WEBHOOK_BATCH_SIZE", 100).AsInt(),
+			Timeout:        env.New("WEBHOOK_TIMEOUT", 10).AsInt(),
+			MaxAttempts:    env.New("WEBHOOK_MAX_ATTEMPTS", 10).AsInt(),
+			RetryBaseDelay: env.New("WEBHOOK_RETRY_BASE_DELAY", 30).AsInt(),
+			RetryMaxDelay:  env.New("WEBHOOK_RETRY_MAX_DELAY", 21600).AsInt(),
+		},
+		Stream: StreamConfig{
+			PollInterval:      env.New("STREAM_POLL_INTERVAL", 1000).AsInt(),
+			BatchSize:         env.New("STREAM_BATCH_SIZE", 100).AsInt(),
+			HeartbeatInterval: env.New("STREAM_HEARTBEAT_INTERVAL", 15).AsInt(),
+		},
 	}
 }
 